@@ -1,13 +1,31 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/gex/pkg/layout"
+	"github.com/ignite/gex/pkg/theme"
+	"github.com/ignite/gex/pkg/widget"
 	"github.com/ignite/gex/pkg/xurl"
 	"github.com/ignite/gex/services/explorer"
 )
 
-const defaultHost = "http://localhost:26657"
+const (
+	defaultHost = "http://localhost:26657"
+
+	flagWatch = "watch"
+	flagBell  = "bell"
+
+	flagBlockIntervalBuckets = "block-interval-buckets"
+	flagBlockIntervalWindow  = "block-interval-window"
+	flagLayout               = "layout"
+	flagTheme                = "theme"
+)
 
 // NewExplorer creates a new explorer command.
 func NewExplorer() *cobra.Command {
@@ -26,9 +44,100 @@ func NewExplorer() *cobra.Command {
 				return err
 			}
 
-			return explorer.Run(cmd.Context(), hostURL.String())
+			watch, err := cmd.Flags().GetStringSlice(flagWatch)
+			if err != nil {
+				return err
+			}
+			for _, address := range watch {
+				if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+					return errors.Wrapf(err, "invalid watch address %s", address)
+				}
+			}
+
+			bell, err := cmd.Flags().GetBool(flagBell)
+			if err != nil {
+				return err
+			}
+
+			buckets, err := cmd.Flags().GetDurationSlice(flagBlockIntervalBuckets)
+			if err != nil {
+				return err
+			}
+			for _, bucket := range buckets {
+				if bucket <= 0 {
+					return errors.Errorf("invalid block interval bucket %s: must be positive", bucket)
+				}
+			}
+
+			intervalWindow, err := cmd.Flags().GetInt(flagBlockIntervalWindow)
+			if err != nil {
+				return err
+			}
+			if intervalWindow <= 0 {
+				return errors.Errorf("invalid block interval window %d: must be positive", intervalWindow)
+			}
+
+			opts := []explorer.Option{
+				explorer.WithWatchAddresses(watch...),
+				explorer.WithBlockIntervalBuckets(buckets...),
+				explorer.WithBlockIntervalWindow(intervalWindow),
+			}
+			if bell {
+				opts = append(opts, explorer.WithBell())
+			}
+
+			layoutPath, err := cmd.Flags().GetString(flagLayout)
+			if err != nil {
+				return err
+			}
+			themeName := theme.Default
+			if layoutPath != "" {
+				l, err := layout.Load(layoutPath)
+				if err != nil {
+					return err
+				}
+				if err := widget.ValidateLayout(l); err != nil {
+					return errors.Wrapf(err, "layout file %s", layoutPath)
+				}
+				if l.Theme != "" {
+					themeName = l.Theme
+				}
+				opts = append(opts, explorer.WithLayout(l))
+			}
+
+			if cmd.Flags().Changed(flagTheme) {
+				if themeName, err = cmd.Flags().GetString(flagTheme); err != nil {
+					return err
+				}
+			}
+			t, err := theme.Select(themeName)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, explorer.WithTheme(t))
+
+			return explorer.Run(cmd.Context(), hostURL.String(), opts...)
 		},
 	}
 
+	cmd.Flags().StringSlice(flagWatch, nil, "addresses to watch the balances of (comma separated or repeated)")
+	cmd.Flags().Bool(flagBell, false, "ring the terminal bell when the chain halts")
+	cmd.Flags().DurationSlice(
+		flagBlockIntervalBuckets,
+		nil,
+		"upper bounds of the block interval histogram buckets (e.g. 1s,2s,5s)",
+	)
+	cmd.Flags().Int(
+		flagBlockIntervalWindow,
+		explorer.DefaultBlockIntervalWindow,
+		"number of latest blocks of the block interval histogram",
+	)
+	cmd.Flags().String(flagLayout, "", "YAML or JSON dashboard layout file (defaults to the built-in layout)")
+	cmd.Flags().String(
+		flagTheme,
+		theme.Default,
+		fmt.Sprintf("color theme (%s), overrides the layout file theme and is ignored if NO_COLOR is set", strings.Join(theme.Names(), ", ")),
+	)
+
 	return cmd
 }
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// Balances fetch all balances of an address at the given height, or at the latest height if zero.
+func (c Client) Balances(ctx context.Context, address string, height int64) (sdk.Coins, error) {
+	if height > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	}
+
+	var (
+		queryClient = banktypes.NewQueryClient(c.Context())
+		balances    = sdk.NewCoins()
+		pagination  = &query.PageRequest{}
+	)
+	for {
+		res, err := queryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances = balances.Add(res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
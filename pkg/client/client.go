@@ -4,13 +4,26 @@ import (
 	"context"
 	"time"
 
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ignite/cli/v28/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/v28/ignite/pkg/errors"
 )
 
-const tickerTime = 1 * time.Second
+const (
+	tickerTime = 1 * time.Second
+
+	// validatorsPerPage is the max number of validators per page allowed by the RPC.
+	validatorsPerPage = 100
+
+	// unconfirmedTxsLimit is the max number of unconfirmed transactions allowed by the RPC.
+	unconfirmedTxsLimit = 100
+)
 
 // Client gex client.
 type Client struct {
@@ -63,6 +76,43 @@ func (c Client) NewRoundStep(ctx context.Context, fn func(types.EventDataRoundSt
 	)
 }
 
+// Timeout listen the timeout propose and timeout wait events from the websocket subscriber.
+func (c Client) Timeout(ctx context.Context, fn func(event string, state types.EventDataRoundState) error) error {
+	for event, query := range map[string]string{
+		types.EventTimeoutPropose: types.EventQueryTimeoutPropose.String(),
+		types.EventTimeoutWait:    types.EventQueryTimeoutWait.String(),
+	} {
+		event := event
+		err := c.Subscribe(ctx, event, query, func(resultEvent coretypes.ResultEvent) error {
+			timeoutEvent, ok := resultEvent.Data.(types.EventDataRoundState)
+			if !ok {
+				return errors.Errorf("invalid event timeout type: %v", resultEvent.Data)
+			}
+			return fn(event, timeoutEvent)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Vote listen the new vote event from the websocket subscriber.
+func (c Client) Vote(ctx context.Context, fn func(types.EventDataVote) error) error {
+	return c.Subscribe(
+		ctx,
+		types.EventVote,
+		types.EventQueryVote.String(),
+		func(event coretypes.ResultEvent) error {
+			voteEvent, ok := event.Data.(types.EventDataVote)
+			if !ok {
+				return errors.Errorf("invalid event vote type: %v", event.Data)
+			}
+			return fn(voteEvent)
+		},
+	)
+}
+
 // Tx listen the new transaction event from the websocket subscriber.
 func (c Client) Tx(ctx context.Context, fn func(types.EventDataTx) error) error {
 	return c.Subscribe(
@@ -104,6 +154,40 @@ func (c Client) Subscribe(ctx context.Context, subscriber, query string, fn func
 	return nil
 }
 
+// SyncInfo fetch the node sync information for each tick.
+// The error is forwarded to the callback since the node may not be reachable.
+func (c Client) SyncInfo(ctx context.Context, fn func(coretypes.SyncInfo, error) error) {
+	Callback(ctx, tickerTime, func() error {
+		status, err := c.Status(ctx)
+		if err != nil {
+			return fn(coretypes.SyncInfo{}, err)
+		}
+		return fn(status.SyncInfo, nil)
+	})
+}
+
+// AppInfo holds the ABCI application info and the node info.
+type AppInfo struct {
+	ABCI     abci.ResponseInfo
+	NodeInfo p2p.DefaultNodeInfo
+}
+
+// AppInfo fetch the ABCI application info and the node info for each interval.
+// The error is forwarded to the callback since the node may not be reachable.
+func (c Client) AppInfo(ctx context.Context, interval time.Duration, fn func(AppInfo, error) error) {
+	Callback(ctx, interval, func() error {
+		abciInfo, err := c.RPC.ABCIInfo(ctx)
+		if err != nil {
+			return fn(AppInfo{}, err)
+		}
+		status, err := c.Status(ctx)
+		if err != nil {
+			return fn(AppInfo{}, err)
+		}
+		return fn(AppInfo{ABCI: abciInfo.Response, NodeInfo: status.NodeInfo}, nil)
+	})
+}
+
 // NetInfo fetch the network information for each new block.
 func (c Client) NetInfo(ctx context.Context, fn func(coretypes.ResultNetInfo) error) {
 	c.BlockCallback(ctx, func(int64) error {
@@ -125,13 +209,46 @@ func (c Client) Health(ctx context.Context, fn func(*coretypes.ResultHealth, err
 // Validators fetch the validators information for each new block.
 func (c Client) Validators(ctx context.Context, fn func(coretypes.ResultValidators) error) {
 	c.BlockCallback(ctx, func(height int64) error {
-		page := 1
-		count := 1_000
-		validators, err := c.RPC.Validators(ctx, &height, &page, &count)
+		validators, err := c.ValidatorsAt(ctx, height)
 		if err != nil {
 			return err
 		}
-		return fn(*validators)
+		return fn(validators)
+	})
+}
+
+// ValidatorsAt fetch all pages of the validator set at the given height.
+func (c Client) ValidatorsAt(ctx context.Context, height int64) (coretypes.ResultValidators, error) {
+	var (
+		page   = 1
+		count  = validatorsPerPage
+		result = coretypes.ResultValidators{BlockHeight: height}
+	)
+	for {
+		validators, err := c.RPC.Validators(ctx, &height, &page, &count)
+		if err != nil {
+			return coretypes.ResultValidators{}, err
+		}
+		result.Validators = append(result.Validators, validators.Validators...)
+		result.Count = len(result.Validators)
+		result.Total = validators.Total
+		if validators.Count == 0 || result.Count >= validators.Total {
+			return result, nil
+		}
+		page++
+	}
+}
+
+// Mempool fetch the mempool size and the oldest unconfirmed transactions for each new block.
+// The error is forwarded to the callback since the node may not be reachable.
+func (c Client) Mempool(ctx context.Context, fn func(coretypes.ResultUnconfirmedTxs, error) error) {
+	c.BlockCallback(ctx, func(int64) error {
+		limit := unconfirmedTxsLimit
+		unconfirmedTxs, err := c.RPC.UnconfirmedTxs(ctx, &limit)
+		if err != nil {
+			return fn(coretypes.ResultUnconfirmedTxs{}, err)
+		}
+		return fn(*unconfirmedTxs, nil)
 	})
 }
 
@@ -146,6 +263,59 @@ func (c Client) ConsensusParams(ctx context.Context, fn func(coretypes.ResultCon
 	})
 }
 
+// StakingValidators fetch the staking module validators for each interval of blocks.
+// The error is forwarded to the callback since the staking module may not be reachable.
+func (c Client) StakingValidators(ctx context.Context, interval int64, fn func([]stakingtypes.Validator, error) error) {
+	c.IntervalBlockCallback(ctx, interval, func(int64) error {
+		return fn(c.stakingValidators(ctx))
+	})
+}
+
+// stakingValidators fetch all validators from the staking module.
+func (c Client) stakingValidators(ctx context.Context) ([]stakingtypes.Validator, error) {
+	var (
+		queryClient = stakingtypes.NewQueryClient(c.Context())
+		validators  = make([]stakingtypes.Validator, 0)
+		pagination  = &query.PageRequest{}
+	)
+	for {
+		res, err := queryClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return validators, nil
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// UpgradePlan fetch the current upgrade plan for each interval of blocks.
+// The plan is nil when no upgrade is scheduled, and the error is forwarded
+// to the callback since the upgrade module may not be reachable.
+func (c Client) UpgradePlan(ctx context.Context, interval int64, fn func(*upgradetypes.Plan, error) error) {
+	c.IntervalBlockCallback(ctx, interval, func(int64) error {
+		res, err := upgradetypes.NewQueryClient(c.Context()).CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+		if err != nil {
+			return fn(nil, err)
+		}
+		return fn(res.Plan, nil)
+	})
+}
+
+// IntervalBlockCallback execute the callback for the first new block and then each interval of blocks.
+func (c Client) IntervalBlockCallback(ctx context.Context, interval int64, fn func(height int64) error) {
+	var lastHeight int64
+	c.BlockCallback(ctx, func(height int64) error {
+		if lastHeight > 0 && height-lastHeight < interval {
+			return nil
+		}
+		lastHeight = height
+		return fn(height)
+	})
+}
+
 // BlockCallback execute the callback for each new block.
 func (c Client) BlockCallback(ctx context.Context, fn func(height int64) error) {
 	Callback(ctx, tickerTime, func() error {
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/types"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+)
+
+// nilVote is the vote description used by the consensus state for votes not received.
+const nilVote = "nil-Vote"
+
+// RoundState holds the consensus round state of the node.
+type RoundState struct {
+	Height   int64
+	Round    int32
+	Step     cstypes.RoundStepType
+	Proposer types.ValidatorInfo
+
+	// Prevotes and Precommits flag the received votes ordered by validator index.
+	Prevotes   []bool
+	Precommits []bool
+}
+
+// roundVotes holds the votes of a round from the consensus height vote set.
+type roundVotes struct {
+	Round              int32    `json:"round"`
+	Prevotes           []string `json:"prevotes"`
+	PrevotesBitArray   string   `json:"prevotes_bit_array"`
+	Precommits         []string `json:"precommits"`
+	PrecommitsBitArray string   `json:"precommits_bit_array"`
+}
+
+// ConsensusState fetch the consensus round state for each tick.
+// The error is forwarded to the callback since the node may not be reachable.
+func (c Client) ConsensusState(ctx context.Context, fn func(RoundState, error) error) {
+	Callback(ctx, tickerTime, func() error {
+		return fn(c.RoundState(ctx))
+	})
+}
+
+// RoundState fetch and parse the current consensus round state.
+func (c Client) RoundState(ctx context.Context) (RoundState, error) {
+	result, err := c.RPC.ConsensusState(ctx)
+	if err != nil {
+		return RoundState{}, err
+	}
+	return parseRoundState(result.RoundState)
+}
+
+// parseRoundState parses the round state of the node consensus state.
+func parseRoundState(raw json.RawMessage) (RoundState, error) {
+	var simple cstypes.RoundStateSimple
+	if err := cmtjson.Unmarshal(raw, &simple); err != nil {
+		return RoundState{}, errors.Wrap(err, "invalid consensus round state")
+	}
+
+	var (
+		state RoundState
+		step  int
+	)
+	if _, err := fmt.Sscanf(simple.HeightRoundStep, "%d/%d/%d", &state.Height, &state.Round, &step); err != nil {
+		return RoundState{}, errors.Wrapf(err, "invalid height/round/step %s", simple.HeightRoundStep)
+	}
+	state.Step = cstypes.RoundStepType(step)
+	state.Proposer = simple.Proposer
+
+	var votes []roundVotes
+	if err := cmtjson.Unmarshal(simple.Votes, &votes); err != nil {
+		return RoundState{}, errors.Wrap(err, "invalid consensus height vote set")
+	}
+	for _, v := range votes {
+		if v.Round != state.Round {
+			continue
+		}
+		state.Prevotes = receivedVotes(v.Prevotes)
+		state.Precommits = receivedVotes(v.Precommits)
+	}
+	return state, nil
+}
+
+// receivedVotes flags the received votes from the vote descriptions.
+func receivedVotes(votes []string) []bool {
+	received := make([]bool, len(votes))
+	for i, vote := range votes {
+		received[i] = vote != nilVote
+	}
+	return received
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"testing"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/stretchr/testify/require"
+)
+
+// roundStateDump is the round state of a dump_consensus_state response captured
+// on a 4 validators network, in the second round of the height.
+const roundStateDump = `{
+  "height/round/step": "1523/1/6",
+  "start_time": "2024-03-05T10:15:36.812214553Z",
+  "proposal_block_hash": "8D4C3B0A4E2F1F6A0C7B9E5D3A2B1C0D9E8F7A6B5C4D3E2F1A0B9C8D7E6F5A4B",
+  "locked_block_hash": "",
+  "valid_block_hash": "",
+  "height_vote_set": [
+    {
+      "round": 0,
+      "prevotes": [
+        "Vote{0:3B5F1A2C9D4E 1523/00/SIGNED_MSG_TYPE_PREVOTE(Prevote) 000000000000 7A1E9C3D5B2F 000000000000 @ 2024-03-05T10:15:34.104281907Z}",
+        "nil-Vote",
+        "Vote{2:9C1D7E4B2A6F 1523/00/SIGNED_MSG_TYPE_PREVOTE(Prevote) 000000000000 2F8A4C6E1D3B 000000000000 @ 2024-03-05T10:15:34.118740122Z}",
+        "nil-Vote"
+      ],
+      "prevotes_bit_array": "BA{4:x_x_} 20/40 = 0.50",
+      "precommits": [
+        "Vote{0:3B5F1A2C9D4E 1523/00/SIGNED_MSG_TYPE_PRECOMMIT(Precommit) 000000000000 4D2B8F1A6C3E 000000000000 @ 2024-03-05T10:15:35.311901538Z}",
+        "nil-Vote",
+        "nil-Vote",
+        "nil-Vote"
+      ],
+      "precommits_bit_array": "BA{4:x___} 10/40 = 0.25"
+    },
+    {
+      "round": 1,
+      "prevotes": [
+        "Vote{0:3B5F1A2C9D4E 1523/01/SIGNED_MSG_TYPE_PREVOTE(Prevote) 8D4C3B0A4E2F 1E6A9D3C7B5F 000000000000 @ 2024-03-05T10:15:37.002153884Z}",
+        "Vote{1:5E2A8C1F7B3D 1523/01/SIGNED_MSG_TYPE_PREVOTE(Prevote) 8D4C3B0A4E2F 6B3F1D9A2C8E 000000000000 @ 2024-03-05T10:15:37.010472551Z}",
+        "Vote{2:9C1D7E4B2A6F 1523/01/SIGNED_MSG_TYPE_PREVOTE(Prevote) 8D4C3B0A4E2F 3C7E5A1B9D2F 000000000000 @ 2024-03-05T10:15:37.015839206Z}",
+        "nil-Vote"
+      ],
+      "prevotes_bit_array": "BA{4:xxx_} 30/40 = 0.75",
+      "precommits": [
+        "nil-Vote",
+        "Vote{1:5E2A8C1F7B3D 1523/01/SIGNED_MSG_TYPE_PRECOMMIT(Precommit) 8D4C3B0A4E2F 9A4E2C6B1F3D 000000000000 @ 2024-03-05T10:15:37.402918445Z}",
+        "nil-Vote",
+        "nil-Vote"
+      ],
+      "precommits_bit_array": "BA{4:_x__} 10/40 = 0.25"
+    },
+    {
+      "round": 2,
+      "prevotes": [
+        "nil-Vote",
+        "nil-Vote",
+        "nil-Vote",
+        "nil-Vote"
+      ],
+      "prevotes_bit_array": "BA{4:____} 0/40 = 0.00",
+      "precommits": [
+        "nil-Vote",
+        "nil-Vote",
+        "nil-Vote",
+        "nil-Vote"
+      ],
+      "precommits_bit_array": "BA{4:____} 0/40 = 0.00"
+    }
+  ],
+  "proposer": {
+    "address": "5E2A8C1F7B3D9A4E6C2B1F8D3A7E5C9B1D4F2A6E",
+    "index": 1
+  }
+}`
+
+func TestParseRoundState(t *testing.T) {
+	state, err := parseRoundState([]byte(roundStateDump))
+	require.NoError(t, err)
+	require.Equal(t, int64(1523), state.Height)
+	require.Equal(t, int32(1), state.Round)
+	require.Equal(t, cstypes.RoundStepPrecommit, state.Step)
+	require.Equal(t, "5E2A8C1F7B3D9A4E6C2B1F8D3A7E5C9B1D4F2A6E", state.Proposer.Address.String())
+	require.Equal(t, int32(1), state.Proposer.Index)
+
+	// Only the votes of the current round are kept.
+	require.Equal(t, []bool{true, true, true, false}, state.Prevotes)
+	require.Equal(t, []bool{false, true, false, false}, state.Precommits)
+}
+
+func TestParseRoundStateInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{
+			name:    "invalid json",
+			raw:     `{`,
+			wantErr: "invalid consensus round state",
+		},
+		{
+			name:    "invalid height round step",
+			raw:     `{"height/round/step": "1523-1-6", "height_vote_set": []}`,
+			wantErr: "invalid height/round/step 1523-1-6",
+		},
+		{
+			name:    "invalid height vote set",
+			raw:     `{"height/round/step": "1523/1/6", "height_vote_set": {}}`,
+			wantErr: "invalid consensus height vote set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRoundState([]byte(tt.raw))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReceivedVotes(t *testing.T) {
+	tests := []struct {
+		name  string
+		votes []string
+		want  []bool
+	}{
+		{"no votes", nil, []bool{}},
+		{"all missing", []string{nilVote, nilVote}, []bool{false, false}},
+		{
+			name: "received and missing",
+			votes: []string{
+				"Vote{0:3B5F1A2C9D4E 1523/00/SIGNED_MSG_TYPE_PREVOTE(Prevote) 000000000000 7A1E9C3D5B2F 000000000000 @ 2024-03-05T10:15:34.104281907Z}",
+				nilVote,
+			},
+			want: []bool{true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, receivedVotes(tt.votes))
+		})
+	}
+}
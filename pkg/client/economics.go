@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Economics holds the staking denom supply and the chain tokenomics.
+// The mint and distribution fields are left empty when their module is not
+// reachable, and the module name is added to Unavailable.
+type Economics struct {
+	BondDenom        string
+	Supply           sdk.Coin
+	BondedTokens     math.Int
+	NotBondedTokens  math.Int
+	Inflation        math.LegacyDec
+	AnnualProvisions math.LegacyDec
+	CommunityPool    sdk.DecCoins
+	Unavailable      []string
+}
+
+// Economics fetch the staking economics for each interval of blocks.
+// The error is forwarded to the callback since the staking and bank modules
+// may not be reachable.
+func (c Client) Economics(ctx context.Context, interval int64, fn func(Economics, error) error) {
+	c.IntervalBlockCallback(ctx, interval, func(int64) error {
+		return fn(c.economics(ctx))
+	})
+}
+
+// economics fetch the staking pool, the supply of the staking denom, the
+// mint inflation and provisions and the community pool.
+func (c Client) economics(ctx context.Context) (Economics, error) {
+	var (
+		stakingClient = stakingtypes.NewQueryClient(c.Context())
+		bankClient    = banktypes.NewQueryClient(c.Context())
+		mintClient    = minttypes.NewQueryClient(c.Context())
+		distrClient   = distrtypes.NewQueryClient(c.Context())
+	)
+
+	params, err := stakingClient.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return Economics{}, err
+	}
+	pool, err := stakingClient.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return Economics{}, err
+	}
+	supply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: params.Params.BondDenom})
+	if err != nil {
+		return Economics{}, err
+	}
+
+	economics := Economics{
+		BondDenom:       params.Params.BondDenom,
+		Supply:          supply.Amount,
+		BondedTokens:    pool.Pool.BondedTokens,
+		NotBondedTokens: pool.Pool.NotBondedTokens,
+	}
+
+	inflation, inflationErr := mintClient.Inflation(ctx, &minttypes.QueryInflationRequest{})
+	provisions, provisionsErr := mintClient.AnnualProvisions(ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if inflationErr != nil || provisionsErr != nil {
+		economics.Unavailable = append(economics.Unavailable, minttypes.ModuleName)
+	} else {
+		economics.Inflation = inflation.Inflation
+		economics.AnnualProvisions = provisions.AnnualProvisions
+	}
+
+	communityPool, err := distrClient.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		economics.Unavailable = append(economics.Unavailable, distrtypes.ModuleName)
+	} else {
+		economics.CommunityPool = communityPool.Pool
+	}
+	return economics, nil
+}
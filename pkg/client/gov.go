@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// Proposal is a governance proposal with its current tally.
+type Proposal struct {
+	govv1.Proposal
+	Tally govv1.TallyResult
+}
+
+// Governance holds the active governance proposals and the params to tally them.
+type Governance struct {
+	Params       govv1.Params
+	BondedTokens math.Int
+	Proposals    []Proposal
+}
+
+// Governance fetch the proposals in deposit and voting period for each interval of blocks.
+// The error is forwarded to the callback since the gov module may not be reachable.
+func (c Client) Governance(ctx context.Context, interval int64, fn func(Governance, error) error) {
+	c.IntervalBlockCallback(ctx, interval, func(int64) error {
+		return fn(c.governance(ctx))
+	})
+}
+
+// GovProposal fetch a governance proposal by id.
+func (c Client) GovProposal(ctx context.Context, id uint64) (govv1.Proposal, error) {
+	res, err := c.govQueryClient().Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: id})
+	if err != nil {
+		return govv1.Proposal{}, err
+	}
+	if res.Proposal == nil {
+		return govv1.Proposal{}, errors.Errorf("proposal %d not found", id)
+	}
+	return *res.Proposal, nil
+}
+
+// govQueryClient returns the gov query client. The queries go through the raw
+// query connection because the client context unpacks the proposal messages
+// and fails on the message types it does not register, e.g. the chain modules.
+func (c Client) govQueryClient() govv1.QueryClient {
+	return govv1.NewQueryClient(rawQueryConn{c})
+}
+
+// governance fetch the gov params, the bonded tokens and the active proposals.
+// The voting period proposals are tallied with the current votes.
+func (c Client) governance(ctx context.Context) (Governance, error) {
+	govClient := c.govQueryClient()
+	params, err := govClient.Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		return Governance{}, err
+	}
+	if params.Params == nil {
+		return Governance{}, errors.New("empty gov params")
+	}
+
+	pool, err := stakingtypes.NewQueryClient(c.Context()).Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return Governance{}, err
+	}
+
+	gov := Governance{Params: *params.Params, BondedTokens: pool.Pool.BondedTokens}
+	for _, status := range []govv1.ProposalStatus{
+		govv1.StatusVotingPeriod,
+		govv1.StatusDepositPeriod,
+	} {
+		proposals, err := govProposals(ctx, govClient, status)
+		if err != nil {
+			return Governance{}, err
+		}
+		for _, proposal := range proposals {
+			p := Proposal{Proposal: *proposal}
+			if status == govv1.StatusVotingPeriod {
+				tally, err := govClient.TallyResult(ctx, &govv1.QueryTallyResultRequest{ProposalId: proposal.Id})
+				if err != nil {
+					return Governance{}, err
+				}
+				if tally.Tally != nil {
+					p.Tally = *tally.Tally
+				}
+			}
+			gov.Proposals = append(gov.Proposals, p)
+		}
+	}
+	return gov, nil
+}
+
+// govProposals fetch all proposals with the status.
+func govProposals(ctx context.Context, govClient govv1.QueryClient, status govv1.ProposalStatus) ([]*govv1.Proposal, error) {
+	var (
+		proposals  = make([]*govv1.Proposal, 0)
+		pagination = &query.PageRequest{}
+	)
+	for {
+		res, err := govClient.Proposals(ctx, &govv1.QueryProposalsRequest{ProposalStatus: status, Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, res.Proposals...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return proposals, nil
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// rawQueryConn is a gRPC client connection running the queries through the ABCI
+// query of the node RPC. Unlike the client context, the responses are decoded
+// without unpacking their interfaces.
+type rawQueryConn struct {
+	c Client
+}
+
+// Invoke runs the query method through the ABCI query.
+func (r rawQueryConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	req, ok := args.(proto.Message)
+	if !ok {
+		return errors.Errorf("invalid query request type %T", args)
+	}
+	res, ok := reply.(proto.Message)
+	if !ok {
+		return errors.Errorf("invalid query response type %T", reply)
+	}
+
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	result, err := r.c.RPC.ABCIQuery(ctx, method, data)
+	if err != nil {
+		return err
+	}
+	if !result.Response.IsOK() {
+		return errors.Errorf("query %s failed: %s", method, result.Response.Log)
+	}
+	return proto.Unmarshal(result.Response.Value, res)
+}
+
+// NewStream is not supported since the ABCI query has no streaming.
+func (rawQueryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streaming is not supported by the ABCI query")
+}
@@ -0,0 +1,63 @@
+package cosmostx
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+)
+
+// Tx holds the summary of a Cosmos SDK transaction.
+type Tx struct {
+	Hash     string
+	Messages []string
+	Memo     string
+	GasLimit uint64
+	Fee      sdk.Coins
+	Payer    string
+	Granter  string
+}
+
+// Decode decodes the raw transaction bytes into a transaction summary with the fee sorted by denom.
+// The messages are not unpacked, so the decoding does not depend on the
+// message types registered by the chain. A fee coin with an invalid denom
+// or a nil or negative amount is rejected.
+func Decode(bz []byte) (Tx, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(bz); err != nil {
+		return Tx{}, errors.Wrap(err, "invalid raw tx")
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return Tx{}, errors.Wrap(err, "invalid tx body")
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return Tx{}, errors.Wrap(err, "invalid tx auth info")
+	}
+
+	tx := Tx{
+		Hash:     fmt.Sprintf("%X", types.Tx(bz).Hash()),
+		Messages: make([]string, len(body.Messages)),
+		Memo:     body.Memo,
+	}
+	for i, msg := range body.Messages {
+		tx.Messages[i] = msg.TypeUrl
+	}
+	if authInfo.Fee != nil {
+		tx.GasLimit = authInfo.Fee.GasLimit
+		tx.Fee = authInfo.Fee.Amount.Sort()
+		tx.Payer = authInfo.Fee.Payer
+		tx.Granter = authInfo.Fee.Granter
+	}
+	for _, coin := range tx.Fee {
+		if err := coin.Validate(); err != nil {
+			return Tx{}, errors.Wrap(err, "invalid tx fee")
+		}
+	}
+	return tx, nil
+}
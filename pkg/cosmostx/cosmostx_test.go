@@ -0,0 +1,102 @@
+package cosmostx
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	body, err := (&txtypes.TxBody{
+		Messages: []*codectypes.Any{
+			{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{0x0a, 0x01, 0x61}},
+			{TypeUrl: "/mars.mars.MsgUnknown"},
+		},
+		Memo: "memo",
+	}).Marshal()
+	require.NoError(t, err)
+
+	authInfo, err := (&txtypes.AuthInfo{
+		Fee: &txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+			GasLimit: 200_000,
+			Payer:    "cosmos1payer",
+		},
+	}).Marshal()
+	require.NoError(t, err)
+
+	bz, err := (&txtypes.TxRaw{BodyBytes: body, AuthInfoBytes: authInfo}).Marshal()
+	require.NoError(t, err)
+
+	// The fee coin amount field is omitted so it decodes to a nil amount,
+	// the marshaling of a coin always writing its amount.
+	var (
+		nilAmountCoin = append([]byte{0x0a, 0x05}, "stake"...)
+		nilAmountFee  = append([]byte{0x0a, byte(len(nilAmountCoin))}, nilAmountCoin...)
+	)
+	nilAmountBz, err := (&txtypes.TxRaw{
+		BodyBytes:     body,
+		AuthInfoBytes: append([]byte{0x12, byte(len(nilAmountFee))}, nilAmountFee...),
+	}).Marshal()
+	require.NoError(t, err)
+
+	negativeAuthInfo, err := (&txtypes.AuthInfo{
+		Fee: &txtypes.Fee{Amount: sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}},
+	}).Marshal()
+	require.NoError(t, err)
+
+	negativeBz, err := (&txtypes.TxRaw{BodyBytes: body, AuthInfoBytes: negativeAuthInfo}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		bz      []byte
+		want    Tx
+		wantErr bool
+	}{
+		{
+			name: "valid tx",
+			bz:   bz,
+			want: Tx{
+				Hash:     fmt.Sprintf("%X", types.Tx(bz).Hash()),
+				Messages: []string{"/cosmos.bank.v1beta1.MsgSend", "/mars.mars.MsgUnknown"},
+				Memo:     "memo",
+				GasLimit: 200_000,
+				Fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+				Payer:    "cosmos1payer",
+			},
+		},
+		{
+			name:    "invalid tx",
+			bz:      []byte{0xff, 0xff},
+			wantErr: true,
+		},
+		{
+			name:    "nil fee amount",
+			bz:      nilAmountBz,
+			wantErr: true,
+		},
+		{
+			name:    "negative fee amount",
+			bz:      negativeBz,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.bz)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
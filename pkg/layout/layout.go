@@ -0,0 +1,195 @@
+// Package layout defines the declarative dashboard layout made of pages of
+// nested rows and columns of panels.
+package layout
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/gex/pkg/theme"
+)
+
+// MaxPages is the max number of pages, one for each number key.
+const MaxPages = 9
+
+//go:embed default.yaml
+var defaultLayout []byte
+
+// Layout is a dashboard layout made of pages, with an optional color theme name.
+type Layout struct {
+	Theme string `json:"theme,omitempty" yaml:"theme,omitempty"`
+	Pages []Page `json:"pages" yaml:"pages"`
+}
+
+// Page is a dashboard page.
+type Page struct {
+	Title string `json:"title" yaml:"title"`
+	Root  Node   `json:"root" yaml:"root"`
+}
+
+// Node is a layout entry, either a panel or rows or columns of nodes.
+// The size is the node share of its parent relative to the sizes of its
+// siblings, one if not set.
+type Node struct {
+	Panel   string `json:"panel,omitempty" yaml:"panel,omitempty"`
+	Title   string `json:"title,omitempty" yaml:"title,omitempty"`
+	Size    int    `json:"size,omitempty" yaml:"size,omitempty"`
+	Rows    []Node `json:"rows,omitempty" yaml:"rows,omitempty"`
+	Columns []Node `json:"columns,omitempty" yaml:"columns,omitempty"`
+}
+
+// Children returns the node rows or columns, and true if they are columns.
+func (n Node) Children() ([]Node, bool) {
+	if len(n.Columns) > 0 {
+		return n.Columns, true
+	}
+	return n.Rows, false
+}
+
+// size returns the node size, one if not set.
+func (n Node) size() int {
+	if n.Size == 0 {
+		return 1
+	}
+	return n.Size
+}
+
+// SplitPercent returns the share of the first node over all nodes as a percentage
+// between 1 and 99, the nodes being split as the first node and the others.
+func SplitPercent(nodes []Node) int {
+	total := 0
+	for _, node := range nodes {
+		total += node.size()
+	}
+	percent := int(math.Round(float64(nodes[0].size()) * 100 / float64(total)))
+	return min(max(percent, 1), 99)
+}
+
+// Default returns the built-in layout.
+func Default() Layout {
+	l, err := Parse(defaultLayout, ".yaml")
+	if err != nil {
+		panic(fmt.Sprintf("invalid default layout: %s", err))
+	}
+	return l
+}
+
+// Load reads a YAML or JSON layout file, the format being chosen by the file extension.
+func Load(path string) (Layout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Layout{}, err
+	}
+	l, err := Parse(data, filepath.Ext(path))
+	if err != nil {
+		return Layout{}, errors.Wrapf(err, "invalid layout file %s", path)
+	}
+	return l, nil
+}
+
+// Parse parses a YAML or JSON layout by the file extension, rejecting the unknown fields.
+func Parse(data []byte, ext string) (Layout, error) {
+	var l Layout
+	switch strings.ToLower(ext) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&l); err != nil {
+			return Layout{}, err
+		}
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&l); err != nil {
+			return Layout{}, err
+		}
+	default:
+		return Layout{}, errors.Errorf("unsupported layout format %q, use .yaml, .yml or .json", ext)
+	}
+	return l, nil
+}
+
+// Validate validates the layout theme and the pages against the available panels.
+// The error points at the invalid entry, e.g. pages[1].root.rows[0]: unknown panel "foo".
+func (l Layout) Validate(panels []string) error {
+	if l.Theme != "" {
+		if _, err := theme.Get(l.Theme); err != nil {
+			return errors.Errorf("theme: %s", err)
+		}
+	}
+	if len(l.Pages) == 0 {
+		return errors.New("pages: at least one page is required")
+	}
+	if len(l.Pages) > MaxPages {
+		return errors.Errorf("pages: at most %d pages are allowed, got %d", MaxPages, len(l.Pages))
+	}
+
+	known := make(map[string]bool, len(panels))
+	for _, panel := range panels {
+		known[panel] = true
+	}
+	for i, page := range l.Pages {
+		path := fmt.Sprintf("pages[%d]", i)
+		if page.Title == "" {
+			return errors.Errorf("%s.title: the page title is required", path)
+		}
+		if page.Root.Panel != "" {
+			return errors.Errorf("%s.root: the page root must have rows or columns", path)
+		}
+		if err := page.Root.validate(path+".root", known, make(map[string]string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate validates the node and its children, recording the panels placed in the page.
+func (n Node) validate(path string, known map[string]bool, placed map[string]string) error {
+	switch {
+	case n.Size < 0:
+		return errors.Errorf("%s.size: must be positive, got %d", path, n.Size)
+	case len(n.Rows) > 0 && len(n.Columns) > 0:
+		return errors.Errorf("%s: only one of rows and columns can be set", path)
+	case n.Panel != "" && (len(n.Rows) > 0 || len(n.Columns) > 0):
+		return errors.Errorf("%s: a panel cannot have rows or columns", path)
+	case n.Panel != "":
+		if !known[n.Panel] {
+			return errors.Errorf("%s.panel: unknown panel %q", path, n.Panel)
+		}
+		if previous, ok := placed[n.Panel]; ok {
+			return errors.Errorf("%s.panel: panel %q is already placed in the page at %s", path, n.Panel, previous)
+		}
+		placed[n.Panel] = path
+		return nil
+	}
+
+	children, columns := n.Children()
+	field := "rows"
+	if columns {
+		field = "columns"
+	}
+	switch {
+	case len(children) == 0:
+		return errors.Errorf("%s: one of panel, rows or columns is required", path)
+	case len(children) == 1:
+		return errors.Errorf("%s.%s: at least two entries are required, use a panel instead", path, field)
+	}
+	if n.Title != "" {
+		return errors.Errorf("%s.title: only panels can have a title", path)
+	}
+	for i, child := range children {
+		if err := child.validate(fmt.Sprintf("%s.%s[%d]", path, field, i), known, placed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
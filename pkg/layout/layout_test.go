@@ -0,0 +1,217 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPercent(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []Node
+		want  int
+	}{
+		{"equal nodes", []Node{{}, {}}, 50},
+		{"three equal nodes", []Node{{}, {}, {}}, 33},
+		{"sized nodes", []Node{{Size: 30}, {Size: 70}}, 30},
+		{"first of three sized nodes", []Node{{Size: 30}, {Size: 42}, {Size: 28}}, 30},
+		{"unset size counts as one", []Node{{Size: 3}, {}}, 75},
+		{"clamped to one", []Node{{Size: 1}, {Size: 1000}}, 1},
+		{"clamped to ninety-nine", []Node{{Size: 1000}, {Size: 1}}, 99},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, SplitPercent(tt.nodes))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	want := Layout{Theme: "dark", Pages: []Page{{
+		Title: "Blocks",
+		Root: Node{Columns: []Node{
+			{Panel: "blocks", Title: "My Blocks", Size: 30},
+			{Panel: "transactions", Size: 70},
+		}},
+	}}}
+	tests := []struct {
+		name    string
+		data    string
+		ext     string
+		want    Layout
+		wantErr string
+	}{
+		{
+			name: "yaml",
+			data: `
+theme: dark
+pages:
+  - title: Blocks
+    root:
+      columns:
+        - panel: blocks
+          title: My Blocks
+          size: 30
+        - panel: transactions
+          size: 70
+`,
+			ext:  ".yaml",
+			want: want,
+		},
+		{
+			name: "json",
+			data: `{"theme": "dark", "pages": [{"title": "Blocks", "root": {"columns": [
+				{"panel": "blocks", "title": "My Blocks", "size": 30},
+				{"panel": "transactions", "size": 70}
+			]}}]}`,
+			ext:  ".JSON",
+			want: want,
+		},
+		{
+			name:    "unknown yaml field",
+			data:    "pages:\n  - title: Blocks\n    root:\n      colums: []\n",
+			ext:     ".yml",
+			wantErr: "field colums not found",
+		},
+		{
+			name:    "unknown json field",
+			data:    `{"pages": [{"name": "Blocks"}]}`,
+			ext:     ".json",
+			wantErr: `unknown field "name"`,
+		},
+		{
+			name:    "unsupported format",
+			data:    "pages = []",
+			ext:     ".toml",
+			wantErr: `unsupported layout format ".toml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.data), tt.ext)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var (
+		panels = []string{"blocks", "transactions", "fees"}
+		page   = func(root Node) Layout {
+			return Layout{Pages: []Page{{Title: "Page", Root: root}}}
+		}
+	)
+	tests := []struct {
+		name    string
+		layout  Layout
+		wantErr string
+	}{
+		{
+			name: "valid",
+			layout: page(Node{Rows: []Node{
+				{Panel: "blocks"},
+				{Columns: []Node{{Panel: "transactions"}, {Panel: "fees", Title: "Fees"}}},
+			}}),
+		},
+		{
+			name: "valid with theme",
+			layout: Layout{Theme: "dark", Pages: []Page{{
+				Title: "Page",
+				Root:  Node{Columns: []Node{{Panel: "blocks"}, {Panel: "fees"}}},
+			}}},
+		},
+		{
+			name:    "unknown theme",
+			layout:  Layout{Theme: "neon", Pages: []Page{{Title: "Page"}}},
+			wantErr: `theme: unknown theme "neon", use one of default, dark, light, high-contrast, monochrome`,
+		},
+		{
+			name:    "no pages",
+			layout:  Layout{},
+			wantErr: "pages: at least one page is required",
+		},
+		{
+			name:    "too many pages",
+			layout:  Layout{Pages: make([]Page, MaxPages+1)},
+			wantErr: "pages: at most 9 pages are allowed, got 10",
+		},
+		{
+			name:    "missing page title",
+			layout:  Layout{Pages: []Page{{Root: Node{Rows: []Node{{Panel: "blocks"}, {Panel: "fees"}}}}}},
+			wantErr: "pages[0].title: the page title is required",
+		},
+		{
+			name:    "panel root",
+			layout:  page(Node{Panel: "blocks"}),
+			wantErr: "pages[0].root: the page root must have rows or columns",
+		},
+		{
+			name:    "unknown panel",
+			layout:  page(Node{Rows: []Node{{Panel: "blocks"}, {Columns: []Node{{Panel: "fees"}, {Panel: "foo"}}}}}),
+			wantErr: `pages[0].root.rows[1].columns[1].panel: unknown panel "foo"`,
+		},
+		{
+			name:    "panel placed twice",
+			layout:  page(Node{Rows: []Node{{Panel: "blocks"}, {Panel: "blocks"}}}),
+			wantErr: `pages[0].root.rows[1].panel: panel "blocks" is already placed in the page at pages[0].root.rows[0]`,
+		},
+		{
+			name:    "negative size",
+			layout:  page(Node{Rows: []Node{{Panel: "blocks", Size: -1}, {Panel: "fees"}}}),
+			wantErr: "pages[0].root.rows[0].size: must be positive, got -1",
+		},
+		{
+			name: "rows and columns",
+			layout: page(Node{
+				Rows:    []Node{{Panel: "blocks"}, {Panel: "fees"}},
+				Columns: []Node{{Panel: "blocks"}, {Panel: "fees"}},
+			}),
+			wantErr: "pages[0].root: only one of rows and columns can be set",
+		},
+		{
+			name:    "panel with rows",
+			layout:  page(Node{Rows: []Node{{Panel: "blocks", Rows: []Node{{Panel: "fees"}}}, {Panel: "fees"}}}),
+			wantErr: "pages[0].root.rows[0]: a panel cannot have rows or columns",
+		},
+		{
+			name:    "empty node",
+			layout:  page(Node{Columns: []Node{{Panel: "blocks"}, {}}}),
+			wantErr: "pages[0].root.columns[1]: one of panel, rows or columns is required",
+		},
+		{
+			name:    "single row",
+			layout:  page(Node{Rows: []Node{{Panel: "blocks"}}}),
+			wantErr: "pages[0].root.rows: at least two entries are required, use a panel instead",
+		},
+		{
+			name:    "split with title",
+			layout:  page(Node{Title: "Split", Rows: []Node{{Panel: "blocks"}, {Panel: "fees"}}}),
+			wantErr: "pages[0].root.title: only panels can have a title",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.layout.Validate(panels)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	l := Default()
+	require.NotEmpty(t, l.Pages)
+	require.LessOrEqual(t, len(l.Pages), MaxPages)
+	for _, page := range l.Pages {
+		require.NotEmpty(t, page.Title)
+	}
+}
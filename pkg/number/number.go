@@ -17,6 +17,8 @@ package number
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 )
 
@@ -59,3 +61,29 @@ func WithComma(n int64) string {
 		}
 	}
 }
+
+// Percentage returns the part percentage of the total formatted with two decimals.
+func Percentage(part, total int64) string {
+	if total <= 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(part)*100/float64(total))
+}
+
+// Percentile returns the nearest-rank p percentile of the values, with p between 0 and 100.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
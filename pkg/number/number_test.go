@@ -46,3 +46,47 @@ func TestWithComma(t *testing.T) {
 		})
 	}
 }
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		name  string
+		part  int64
+		total int64
+		want  string
+	}{
+		{"zero total", 10, 0, "0.00%"},
+		{"negative total", 10, -1, "0.00%"},
+		{"zero part", 0, 100, "0.00%"},
+		{"half", 50, 100, "50.00%"},
+		{"fraction", 1, 3, "33.33%"},
+		{"over", 150, 100, "150.00%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Percentage(tt.part, tt.total)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		p      float64
+		want   float64
+	}{
+		{"empty", nil, 50, 0},
+		{"single", []float64{3}, 90, 3},
+		{"min", []float64{5, 1, 3}, 0, 1},
+		{"median", []float64{5, 1, 3, 2, 4}, 50, 3},
+		{"p90", []float64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, 90, 9},
+		{"max", []float64{5, 1, 3}, 100, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Percentile(tt.values, tt.p)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
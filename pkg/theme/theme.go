@@ -0,0 +1,134 @@
+// Package theme defines the dashboard color themes.
+package theme
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"github.com/mum4k/termdash/cell"
+)
+
+// Theme names.
+const (
+	Default      = "default"
+	Dark         = "dark"
+	Light        = "light"
+	HighContrast = "high-contrast"
+	Monochrome   = "monochrome"
+)
+
+// noColorEnv is the environment variable disabling the colors when set, see https://no-color.org.
+const noColorEnv = "NO_COLOR"
+
+// Theme holds the dashboard colors.
+type Theme struct {
+	Name string
+
+	// Root is the color of the root container border and title.
+	Root cell.Color
+
+	// Border is the color of the panel borders.
+	Border cell.Color
+
+	// Title is the color of the panel titles.
+	Title cell.Color
+
+	// Focused is the color of the focused panel border and title.
+	Focused cell.Color
+
+	// Text is the color of the panel text.
+	Text cell.Color
+
+	// Accent is the color of the charts and their labels.
+	Accent cell.Color
+
+	// Alert is the color of the alerts and of the root container while a banner is shown.
+	Alert cell.Color
+}
+
+// themes holds the available themes by name.
+var themes = map[string]Theme{
+	Default: {
+		Name:    Default,
+		Root:    cell.ColorNumber(2),
+		Border:  cell.ColorNumber(2),
+		Title:   cell.ColorDefault,
+		Focused: cell.ColorYellow,
+		Text:    cell.ColorDefault,
+		Accent:  cell.ColorGreen,
+		Alert:   cell.ColorRed,
+	},
+	Dark: {
+		Name:    Dark,
+		Root:    cell.ColorNumber(39),
+		Border:  cell.ColorNumber(240),
+		Title:   cell.ColorNumber(252),
+		Focused: cell.ColorNumber(214),
+		Text:    cell.ColorNumber(252),
+		Accent:  cell.ColorNumber(39),
+		Alert:   cell.ColorNumber(203),
+	},
+	Light: {
+		Name:    Light,
+		Root:    cell.ColorNumber(25),
+		Border:  cell.ColorNumber(246),
+		Title:   cell.ColorNumber(235),
+		Focused: cell.ColorNumber(130),
+		Text:    cell.ColorNumber(235),
+		Accent:  cell.ColorNumber(25),
+		Alert:   cell.ColorNumber(160),
+	},
+	HighContrast: {
+		Name:    HighContrast,
+		Root:    cell.ColorWhite,
+		Border:  cell.ColorWhite,
+		Title:   cell.ColorYellow,
+		Focused: cell.ColorCyan,
+		Text:    cell.ColorWhite,
+		Accent:  cell.ColorYellow,
+		Alert:   cell.ColorRed,
+	},
+	Monochrome: {
+		Name:    Monochrome,
+		Root:    cell.ColorDefault,
+		Border:  cell.ColorDefault,
+		Title:   cell.ColorDefault,
+		Focused: cell.ColorDefault,
+		Text:    cell.ColorDefault,
+		Accent:  cell.ColorDefault,
+		Alert:   cell.ColorDefault,
+	},
+}
+
+// Names returns the available theme names.
+func Names() []string {
+	return []string{Default, Dark, Light, HighContrast, Monochrome}
+}
+
+// DefaultTheme returns the default theme.
+func DefaultTheme() Theme {
+	return themes[Default]
+}
+
+// Get returns the theme by name.
+func Get(name string) (Theme, error) {
+	t, ok := themes[name]
+	if !ok {
+		return Theme{}, errors.Errorf("unknown theme %q, use one of %s", name, strings.Join(Names(), ", "))
+	}
+	return t, nil
+}
+
+// Select returns the theme by name, or the monochrome theme if the NO_COLOR
+// environment variable is set to a non-empty value.
+func Select(name string) (Theme, error) {
+	t, err := Get(name)
+	if err != nil {
+		return Theme{}, err
+	}
+	if os.Getenv(noColorEnv) != "" {
+		return themes[Monochrome], nil
+	}
+	return t, nil
+}
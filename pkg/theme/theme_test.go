@@ -0,0 +1,47 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			got, err := Get(name)
+			require.NoError(t, err)
+			require.Equal(t, name, got.Name)
+		})
+	}
+
+	_, err := Get("neon")
+	require.EqualError(t, err, `unknown theme "neon", use one of default, dark, light, high-contrast, monochrome`)
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name    string
+		theme   string
+		noColor string
+		want    string
+		wantErr bool
+	}{
+		{"theme", Dark, "", Dark, false},
+		{"no color", Dark, "1", Monochrome, false},
+		{"empty no color", Light, "", Light, false},
+		{"unknown theme with no color", "neon", "1", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(noColorEnv, tt.noColor)
+			got, err := Select(tt.theme)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Name)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package widget
+
+import (
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+
+	"github.com/ignite/gex/pkg/layout"
+	"github.com/ignite/gex/pkg/theme"
+)
+
+// Option configures the widget.
+type Option func(*Widget)
+
+// WithLayout sets the dashboard layout, validated when the widget is created.
+func WithLayout(l layout.Layout) Option {
+	return func(w *Widget) {
+		w.layout = l
+	}
+}
+
+// ValidateLayout validates the layout against the dashboard panels.
+func ValidateLayout(l layout.Layout) error {
+	w := &Widget{}
+	if err := l.Validate(w.panelNames()); err != nil {
+		return errors.Wrap(err, "invalid layout")
+	}
+	return nil
+}
+
+// WithTheme sets the dashboard color theme.
+func WithTheme(t theme.Theme) Option {
+	return func(w *Widget) {
+		w.theme = t
+	}
+}
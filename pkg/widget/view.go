@@ -16,146 +16,208 @@
 package widget
 
 import (
+	"fmt"
+
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/widgetapi"
+
+	"github.com/ignite/gex/pkg/layout"
 )
 
+// rootID is the identifier of the root container holding the current page.
+const rootID = "root"
+
+// panel is a dashboard panel that can be placed by the layout.
+type panel struct {
+	title  string
+	widget widgetapi.Widget
+}
+
+// panels returns the dashboard panels by name.
+func (w *Widget) panels() map[string]panel {
+	return map[string]panel{
+		"network":           {"Network", w.currentNetwork},
+		"moniker":           {"Moniker", w.moniker},
+		"health":            {"Health", w.health},
+		"time":              {"System Time", w.time},
+		"block-time":        {"Block Time", w.secondsPerBlock},
+		"max-block-size":    {"Max Block Size", w.maxBlockSize},
+		"peers":             {"Connected Peers", w.peers},
+		"validators":        {"Validators", w.validators},
+		"block-progress":    {"Current Block Round", w.blockProgress},
+		"gas-max":           {"Gas Max", w.gasMax},
+		"gas-avg-block":     {"Gas Ø Block", w.gasAvgBlock},
+		"gas-avg-tx":        {"Gas Ø Tx", w.gasAvgTransaction},
+		"gas-latest-tx":     {"Gas Latest Tx", w.latestGas},
+		"blocks":            {"Latest Blocks", w.blocks},
+		"transactions":      {"Latest Confirmed Transactions", w.transactions},
+		"proposers":         {"Block Proposers", w.proposers},
+		"voting-power":      {"Voting Power", w.votingPower},
+		"validator-set":     {"Validator Set Changes", w.validatorSet},
+		"uptime":            {"Validator Uptime", w.uptime},
+		"evidence":          {"Evidence", w.evidence},
+		"round-state":       {"Round State", w.consensus},
+		"round-votes":       {"Round Votes", w.consensusVotes},
+		"rounds":            {"Rounds and Timeouts", w.rounds},
+		"block-intervals":   {"Block Intervals", w.blockIntervals},
+		"throughput":        {"Throughput", w.throughput},
+		"fees":              {"Fees", w.fees},
+		"activity":          {"Activity", w.activity},
+		"accounts":          {"Top Accounts", w.accounts},
+		"mempool-txs":       {"Mempool Txs", w.mempoolTxs},
+		"mempool-bytes":     {"Mempool Bytes", w.mempoolBytes},
+		"mempool":           {"Pending Transactions", w.mempool},
+		"peer-table":        {"Peers (scroll with the mouse wheel or arrow keys)", w.peerTable},
+		"sync-status":       {"Sync Status", w.syncStatus},
+		"upgrade":           {"Scheduled Upgrade", w.upgrade},
+		"app-info":          {"Application", w.appInfo},
+		"governance":        {"Active Proposals", w.governance},
+		"governance-events": {"Proposal Status Changes", w.governanceEvents},
+		"economics":         {"Staking Economics", w.economics},
+		"watch-list":        {"Watched Addresses", w.watchList},
+		"balance-changes":   {"Balance Changes", w.balanceChanges},
+	}
+}
+
+// panelNames returns the names of the dashboard panels.
+func (w *Widget) panelNames() []string {
+	panels := w.panels()
+	names := make([]string, 0, len(panels))
+	for name := range panels {
+		names = append(names, name)
+	}
+	return names
+}
+
+// pages returns all dashboard pages.
+func (w *Widget) pages() []layout.Page {
+	return w.layout.Pages
+}
+
+// title returns the root container title for the current page, prefixed by the banner if any.
+// The caller must hold viewMu.
+func (w *Widget) title() string {
+	pages := w.pages()
+	title := fmt.Sprintf(
+		"GEX: PRESS Q or ESC TO QUIT, TAB or 1-%d TO SWITCH PAGE [%d/%d %s]",
+		len(pages),
+		w.page+1,
+		len(pages),
+		pages[w.page].Title,
+	)
+	if w.banner != "" {
+		title = fmt.Sprintf("%s | %s", w.banner, title)
+	}
+	return title
+}
+
+// borderColor returns the root container border color, the alert color while a banner is shown.
+// The caller must hold viewMu.
+func (w *Widget) borderColor() cell.Color {
+	if w.banner != "" {
+		return w.theme.Alert
+	}
+	return w.theme.Root
+}
+
+// SetBanner shows the banner in the root container title on every page.
+// An empty banner removes it.
+func (w *Widget) SetBanner(banner string) error {
+	w.viewMu.Lock()
+	defer w.viewMu.Unlock()
+
+	if banner == w.banner {
+		return nil
+	}
+	w.banner = banner
+	return w.container.Update(rootID, container.BorderTitle(w.title()), container.BorderColor(w.borderColor()))
+}
+
+// SetPage switches the view to the page index, wrapping around the number of pages.
+func (w *Widget) SetPage(index int) error {
+	w.viewMu.Lock()
+	defer w.viewMu.Unlock()
+	return w.setPage(index)
+}
+
+// movePage switches the view to the page at the offset from the current one.
+func (w *Widget) movePage(offset int) error {
+	w.viewMu.Lock()
+	defer w.viewMu.Unlock()
+	return w.setPage(w.page + offset)
+}
+
+// setPage switches the view to the page index. The caller must hold viewMu.
+func (w *Widget) setPage(index int) error {
+	pages := w.pages()
+	w.page = (index%len(pages) + len(pages)) % len(pages)
+	return w.container.Update(rootID, append(
+		[]container.Option{container.BorderTitle(w.title())},
+		w.nodeOptions(w.panels(), pages[w.page].Root)...,
+	)...)
+}
+
 // drawView draw all containers view.
 func (w *Widget) drawView() (*container.Container, error) {
+	w.viewMu.Lock()
+	defer w.viewMu.Unlock()
+
 	return container.New(
 		w.terminal,
-		container.Border(linestyle.Light),
-		container.BorderTitle("GEX: PRESS Q or ESC TO QUIT"),
-		container.BorderColor(cell.ColorNumber(2)),
-		container.SplitHorizontal(
-			container.Top(
-				container.SplitVertical(
-					container.Left(
-						container.SplitHorizontal(
-							container.Top(
-								container.SplitVertical(
-									container.Left(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Network"),
-												container.PlaceWidget(w.currentNetwork),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Moniker"),
-												container.PlaceWidget(w.moniker),
-											),
-										),
-									),
-									container.Right(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Health"),
-												container.PlaceWidget(w.health),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("System Time"),
-												container.PlaceWidget(w.time),
-											),
-										),
-									),
-								),
-							),
-							container.Bottom(
-								// Insert new bottom rows
-								container.SplitVertical(
-									container.Left(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Block Time"),
-												container.PlaceWidget(w.secondsPerBlock),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Max Block Size"),
-												container.PlaceWidget(w.maxBlockSize),
-											),
-										),
-									),
-									container.Right(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Connected Peers"),
-												container.PlaceWidget(w.peers),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Validators"),
-												container.PlaceWidget(w.validators),
-											),
-										),
-									),
-								),
-							),
-						),
-					),
-					container.Right(
-						container.Border(linestyle.Light),
-						container.BorderTitle("Current Block Round"),
-						container.PlaceWidget(w.blockProgress),
-					),
-				),
-			),
-			container.Bottom(
-				container.SplitVertical(
-					container.Left(
-						container.SplitHorizontal(
-							container.Top(
-								container.SplitVertical(
-									container.Left(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Gas Max"),
-												container.PlaceWidget(w.gasMax),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Gas Ø Block"),
-												container.PlaceWidget(w.gasAvgBlock),
-											),
-										),
-									),
-									container.Right(
-										container.SplitVertical(
-											container.Left(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Gas Ø Tx"),
-												container.PlaceWidget(w.gasAvgTransaction),
-											),
-											container.Right(
-												container.Border(linestyle.Light),
-												container.BorderTitle("Gas Latest Tx"),
-												container.PlaceWidget(w.latestGas),
-											),
-										),
-									),
-								),
-							),
-							container.Bottom(
-								container.Border(linestyle.Light),
-								container.BorderTitle("Latest Blocks"),
-								container.PlaceWidget(w.blocks),
-							),
-						),
-					), container.Right(
-						container.Border(linestyle.Light),
-						container.BorderTitle("Latest Confirmed Transactions"),
-						container.PlaceWidget(w.transactions),
-					),
-				),
-			),
-		),
+		append(
+			[]container.Option{
+				container.ID(rootID),
+				container.Border(linestyle.Light),
+				container.BorderTitle(w.title()),
+				container.BorderColor(w.borderColor()),
+				container.TitleColor(w.theme.Title),
+				container.FocusedColor(w.theme.Focused),
+				container.TitleFocusedColor(w.theme.Focused),
+			},
+			w.nodeOptions(w.panels(), w.pages()[w.page].Root)...,
+		)...,
+	)
+}
+
+// nodeOptions returns the container options of a layout node.
+// The rows and columns are split as the first node and the others, and
+// every split sets its percent explicitly because the root container
+// keeps the split options from the previous page.
+func (w *Widget) nodeOptions(panels map[string]panel, node layout.Node) []container.Option {
+	if node.Panel != "" {
+		p := panels[node.Panel]
+		title := p.title
+		if node.Title != "" {
+			title = node.Title
+		}
+		return []container.Option{
+			container.Border(linestyle.Light),
+			container.BorderTitle(title),
+			container.BorderColor(w.theme.Border),
+			container.TitleColor(w.theme.Title),
+			container.FocusedColor(w.theme.Focused),
+			container.TitleFocusedColor(w.theme.Focused),
+			container.PlaceWidget(p.widget),
+		}
+	}
+
+	children, columns := node.Children()
+	rest := children[1]
+	if len(children) > 2 {
+		rest = layout.Node{Rows: children[1:]}
+		if columns {
+			rest = layout.Node{Columns: children[1:]}
+		}
+	}
+	var (
+		first   = w.nodeOptions(panels, children[0])
+		second  = w.nodeOptions(panels, rest)
+		percent = container.SplitPercent(layout.SplitPercent(children))
 	)
+	if columns {
+		return []container.Option{container.SplitVertical(container.Left(first...), container.Right(second...), percent)}
+	}
+	return []container.Option{container.SplitHorizontal(container.Top(first...), container.Bottom(second...), percent)}
 }
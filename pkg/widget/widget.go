@@ -17,6 +17,7 @@ package widget
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/mum4k/termdash"
@@ -26,7 +27,11 @@ import (
 	"github.com/mum4k/termdash/terminal/termbox"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgets/donut"
+	"github.com/mum4k/termdash/widgets/sparkline"
 	"github.com/mum4k/termdash/widgets/text"
+
+	"github.com/ignite/gex/pkg/layout"
+	"github.com/ignite/gex/pkg/theme"
 )
 
 const loading = "⌛ loading..."
@@ -49,21 +54,63 @@ type Widget struct {
 	transactions      *text.Text
 	blocks            *text.Text
 	moniker           *text.Text
+	proposers         *text.Text
+	uptime            *text.Text
+	votingPower       *text.Text
+	consensus         *text.Text
+	consensusVotes    *text.Text
+	rounds            *text.Text
+	mempool           *text.Text
+	mempoolTxs        *sparkline.SparkLine
+	mempoolBytes      *sparkline.SparkLine
+	peerTable         *text.Text
+	syncStatus        *text.Text
+	throughput        *text.Text
+	activity          *text.Text
+	accounts          *text.Text
+	fees              *text.Text
+	evidence          *text.Text
+	upgrade           *text.Text
+	governance        *text.Text
+	governanceEvents  *text.Text
+	economics         *text.Text
+	watchList         *text.Text
+	balanceChanges    *text.Text
+	validatorSet      *text.Text
+	appInfo           *text.Text
+	blockIntervals    *text.Text
 	blockProgress     *donut.Donut
+	viewMu            sync.Mutex // guards page and banner.
+	writeMu           sync.Mutex // serializes the panel resets and writes.
+	page              int
+	banner            string
+	layout            layout.Layout
+	theme             theme.Theme
+	keyHandlers       map[keyboard.Key]func() error
 }
 
 // New initialize widgets.
-func New() (*Widget, error) {
+func New(options ...Option) (*Widget, error) {
 	var (
-		widget = new(Widget)
-		err    error
+		widget = &Widget{
+			layout:      layout.Default(),
+			theme:       theme.DefaultTheme(),
+			keyHandlers: make(map[keyboard.Key]func() error),
+		}
+		err error
 	)
+	for _, apply := range options {
+		apply(widget)
+	}
+	if err := ValidateLayout(widget.layout); err != nil {
+		return widget, err
+	}
 
 	// Creates Network Widget.
 	if widget.currentNetwork, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.currentNetwork.Write(loading); err != nil {
+	if err := widget.currentNetwork.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -71,7 +118,7 @@ func New() (*Widget, error) {
 	if widget.health, err = text.New(); err != nil {
 		return widget, err
 	}
-	if err := widget.health.Write(loading); err != nil {
+	if err := widget.health.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -80,7 +127,7 @@ func New() (*Widget, error) {
 		return widget, err
 	}
 	currentTime := time.Now()
-	if err := widget.time.Write(currentTime.Format("2006-01-02\n15:04:05")); err != nil {
+	if err := widget.time.Write(currentTime.Format("2006-01-02\n15:04:05"), widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -88,7 +135,7 @@ func New() (*Widget, error) {
 	if widget.peers, err = text.New(); err != nil {
 		return widget, err
 	}
-	if err := widget.peers.Write("0"); err != nil {
+	if err := widget.peers.Write("0", widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -96,7 +143,7 @@ func New() (*Widget, error) {
 	if widget.secondsPerBlock, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.secondsPerBlock.Write("0"); err != nil {
+	if err := widget.secondsPerBlock.Write("0", widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -104,7 +151,7 @@ func New() (*Widget, error) {
 	if widget.maxBlockSize, err = text.New(); err != nil {
 		return widget, err
 	}
-	if err := widget.maxBlockSize.Write("0"); err != nil {
+	if err := widget.maxBlockSize.Write("0", widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -112,7 +159,7 @@ func New() (*Widget, error) {
 	if widget.validators, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.validators.Write(loading); err != nil {
+	if err := widget.validators.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -120,7 +167,7 @@ func New() (*Widget, error) {
 	if widget.gasMax, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.gasMax.Write(loading); err != nil {
+	if err := widget.gasMax.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -128,7 +175,7 @@ func New() (*Widget, error) {
 	if widget.gasAvgBlock, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.gasAvgBlock.Write(loading); err != nil {
+	if err := widget.gasAvgBlock.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -136,7 +183,7 @@ func New() (*Widget, error) {
 	if widget.gasAvgTransaction, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.gasAvgTransaction.Write(loading); err != nil {
+	if err := widget.gasAvgTransaction.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -144,7 +191,7 @@ func New() (*Widget, error) {
 	if widget.latestGas, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.latestGas.Write(loading); err != nil {
+	if err := widget.latestGas.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -152,8 +199,8 @@ func New() (*Widget, error) {
 
 	// Block Status Donut widget.
 	if widget.blockProgress, err = donut.New(
-		donut.CellOpts(cell.FgColor(cell.ColorGreen)),
-		donut.Label("New Block Status", cell.FgColor(cell.ColorGreen)),
+		donut.CellOpts(cell.FgColor(widget.theme.Accent)),
+		donut.Label("New Block Status", cell.FgColor(widget.theme.Accent)),
 	); err != nil {
 		return widget, err
 	}
@@ -162,7 +209,7 @@ func New() (*Widget, error) {
 	if widget.transactions, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.transactions.Write("Transactions will appear as soon as they are confirmed in a moniker."); err != nil {
+	if err := widget.transactions.Write("Transactions will appear as soon as they are confirmed in a moniker.", widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -171,11 +218,207 @@ func New() (*Widget, error) {
 		return widget, err
 	}
 
+	// Block proposers widget.
+	if widget.proposers, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.proposers.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Validator uptime widget.
+	if widget.uptime, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.uptime.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Voting power distribution widget.
+	if widget.votingPower, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.votingPower.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Consensus round state widget.
+	if widget.consensus, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.consensus.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Consensus votes widget.
+	if widget.consensusVotes, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.consensusVotes.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Consensus rounds widget.
+	if widget.rounds, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.rounds.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Mempool pending transactions widget.
+	if widget.mempool, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.mempool.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Mempool size in transactions sparkline widget.
+	if widget.mempoolTxs, err = sparkline.New(
+		sparkline.Label("Pending Txs", cell.FgColor(widget.theme.Accent)),
+		sparkline.Color(widget.theme.Accent),
+	); err != nil {
+		return widget, err
+	}
+
+	// Mempool size in bytes sparkline widget.
+	if widget.mempoolBytes, err = sparkline.New(
+		sparkline.Label("Pending Bytes", cell.FgColor(widget.theme.Accent)),
+		sparkline.Color(widget.theme.Accent),
+	); err != nil {
+		return widget, err
+	}
+
+	// Peer table widget.
+	if widget.peerTable, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.peerTable.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Node sync status widget.
+	if widget.syncStatus, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.syncStatus.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Transactions throughput widget.
+	if widget.throughput, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.throughput.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Message and module activity widget.
+	if widget.activity, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.activity.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Top accounts widget.
+	if widget.accounts, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.accounts.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Fee statistics widget.
+	if widget.fees, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.fees.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Evidence alerts widget.
+	if widget.evidence, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.evidence.Write("No evidence committed during the session.", widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Scheduled upgrade widget.
+	if widget.upgrade, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.upgrade.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Governance proposals widgets.
+	if widget.governance, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.governance.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+	if widget.governanceEvents, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.governanceEvents.Write("No proposal status change during the session.", widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Staking economics widget.
+	if widget.economics, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.economics.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Watched addresses widgets.
+	if widget.watchList, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.watchList.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+	if widget.balanceChanges, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.balanceChanges.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Validator set changes widget.
+	if widget.validatorSet, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.validatorSet.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Application info widget.
+	if widget.appInfo, err = text.New(text.WrapAtWords()); err != nil {
+		return widget, err
+	}
+	if err := widget.appInfo.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
+	// Block intervals histogram widget.
+	if widget.blockIntervals, err = text.New(); err != nil {
+		return widget, err
+	}
+	if err := widget.blockIntervals.Write(loading, widget.textOptions()...); err != nil {
+		return widget, err
+	}
+
 	// Create Blocks parsing widget.
 	if widget.moniker, err = text.New(text.RollContent(), text.WrapAtWords()); err != nil {
 		return widget, err
 	}
-	if err := widget.moniker.Write(loading); err != nil {
+	if err := widget.moniker.Write(loading, widget.textOptions()...); err != nil {
 		return widget, err
 	}
 
@@ -195,6 +438,12 @@ func (w *Widget) Cleanup() {
 	w.terminal.Close()
 }
 
+// OnKey registers a handler executed when the key is pressed.
+// Handlers must be registered before running the widget view.
+func (w *Widget) OnKey(key keyboard.Key, fn func() error) {
+	w.keyHandlers[key] = fn
+}
+
 // Run the widget view.
 func (w *Widget) Run(ctx context.Context) error {
 	defer w.Cleanup()
@@ -202,10 +451,21 @@ func (w *Widget) Run(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	quitter := func(k *terminalapi.Keyboard) {
-		if k.Key == 'q' || k.Key == 'Q' || k.Key == keyboard.KeyEsc {
+	keys := func(k *terminalapi.Keyboard) {
+		switch {
+		case k.Key == 'q' || k.Key == 'Q' || k.Key == keyboard.KeyEsc:
 			cancel()
+		case k.Key == keyboard.KeyTab:
+			_ = w.movePage(1)
+		case k.Key == keyboard.KeyBacktab:
+			_ = w.movePage(-1)
+		case k.Key >= '1' && k.Key <= '9' && int(k.Key-'1') < len(w.pages()):
+			_ = w.SetPage(int(k.Key - '1'))
+		default:
+			if fn, ok := w.keyHandlers[k.Key]; ok {
+				_ = fn()
+			}
 		}
 	}
-	return termdash.Run(ctx, w.terminal, w.container, termdash.KeyboardSubscriber(quitter))
+	return termdash.Run(ctx, w.terminal, w.container, termdash.KeyboardSubscriber(keys))
 }
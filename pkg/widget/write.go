@@ -17,6 +17,7 @@ package widget
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"time"
 
@@ -25,76 +26,233 @@ import (
 	"github.com/mum4k/termdash/widgets/text"
 )
 
+// Line is a text line that can be highlighted as an alert.
+type Line struct {
+	Text  string
+	Alert bool
+}
+
+// textOptions returns the write options prefixed with the theme text color,
+// so the given options take precedence.
+func (w *Widget) textOptions(opts ...text.WriteOption) []text.WriteOption {
+	return append([]text.WriteOption{text.WriteCellOpts(cell.FgColor(w.theme.Text))}, opts...)
+}
+
+// setText resets the text widget and writes the text under the write lock,
+// so the concurrent updates of a panel never interleave.
+func (w *Widget) setText(t *text.Text, txt string, opts ...text.WriteOption) error {
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	t.Reset()
+	return t.Write(txt, w.textOptions(opts...)...)
+}
+
+// setLines resets the text widget and writes the lines under the write lock,
+// highlighting the alert lines.
+func (w *Widget) setLines(t *text.Text, lines []Line) error {
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+
+	t.Reset()
+	for _, line := range lines {
+		opts := w.textOptions()
+		if line.Alert {
+			opts = append(opts, text.WriteCellOpts(cell.FgColor(w.theme.Alert), cell.Bold()))
+		}
+		if err := t.Write(line.Text+"\n", opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetCurrentNetwork reset the widget and set current network text.
 func (w *Widget) SetCurrentNetwork(txt string, opts ...text.WriteOption) error {
-	w.currentNetwork.Reset()
-	return w.currentNetwork.Write(txt, opts...)
+	return w.setText(w.currentNetwork, txt, opts...)
 }
 
 // SetHealth resets the widget and sets health text.
 func (w *Widget) SetHealth(txt string, opts ...text.WriteOption) error {
-	w.health.Reset()
-	return w.health.Write(txt, opts...)
+	return w.setText(w.health, txt, opts...)
 }
 
 // SetTime resets the widget and sets time text.
 func (w *Widget) SetTime(txt string, opts ...text.WriteOption) error {
-	w.time.Reset()
-	return w.time.Write(txt, opts...)
+	return w.setText(w.time, txt, opts...)
 }
 
 // SetPeers resets the widget and sets peers text.
 func (w *Widget) SetPeers(peers int, opts ...text.WriteOption) error {
-	w.peers.Reset()
-	return w.peers.Write(strconv.Itoa(peers), opts...)
+	return w.setText(w.peers, strconv.Itoa(peers), opts...)
 }
 
 // SetSecondsPerBlock resets the widget and sets seconds per block text.
 func (w *Widget) SetSecondsPerBlock(txt string, opts ...text.WriteOption) error {
-	w.secondsPerBlock.Reset()
-	return w.secondsPerBlock.Write(txt, opts...)
+	return w.setText(w.secondsPerBlock, txt, opts...)
 }
 
 // SetMaxBlockSize resets the widget and sets max block size text.
 func (w *Widget) SetMaxBlockSize(txt string, opts ...text.WriteOption) error {
-	w.maxBlockSize.Reset()
-	return w.maxBlockSize.Write(txt, opts...)
+	return w.setText(w.maxBlockSize, txt, opts...)
 }
 
 // SetValidators resets the widget and sets validators text.
 func (w *Widget) SetValidators(validators int, opts ...text.WriteOption) error {
-	w.validators.Reset()
-	return w.validators.Write(strconv.Itoa(validators), opts...)
+	return w.setText(w.validators, strconv.Itoa(validators), opts...)
 }
 
 // SetGasMax resets the widget and sets gas max text.
 func (w *Widget) SetGasMax(txt string, opts ...text.WriteOption) error {
-	w.gasMax.Reset()
-	return w.gasMax.Write(txt, opts...)
+	return w.setText(w.gasMax, txt, opts...)
 }
 
 // SetGasAvgBlock resets the widget and sets gas average per block text.
 func (w *Widget) SetGasAvgBlock(txt string, opts ...text.WriteOption) error {
-	w.gasAvgBlock.Reset()
-	return w.gasAvgBlock.Write(txt, opts...)
+	return w.setText(w.gasAvgBlock, txt, opts...)
 }
 
 // SetGasAvgTransaction resets the widget and sets gas average per transaction text.
 func (w *Widget) SetGasAvgTransaction(txt string, opts ...text.WriteOption) error {
-	w.gasAvgTransaction.Reset()
-	return w.gasAvgTransaction.Write(txt, opts...)
+	return w.setText(w.gasAvgTransaction, txt, opts...)
 }
 
 // SetLatestGas resets the widget and sets latest gas text.
 func (w *Widget) SetLatestGas(txt string, opts ...text.WriteOption) error {
-	w.latestGas.Reset()
-	return w.latestGas.Write(txt, opts...)
+	return w.setText(w.latestGas, txt, opts...)
 }
 
 // SetMoniker resets the widget and sets moniker text.
 func (w *Widget) SetMoniker(text string, opts ...text.WriteOption) error {
-	w.moniker.Reset()
-	return w.moniker.Write(text, opts...)
+	return w.setText(w.moniker, text, opts...)
+}
+
+// SetProposers resets the widget and sets block proposers text.
+func (w *Widget) SetProposers(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.proposers, txt, opts...)
+}
+
+// SetUptime resets the widget and sets validator uptime text.
+func (w *Widget) SetUptime(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.uptime, txt, opts...)
+}
+
+// SetVotingPower resets the widget and sets voting power distribution text.
+func (w *Widget) SetVotingPower(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.votingPower, txt, opts...)
+}
+
+// SetConsensus resets the widget and sets consensus round state text.
+func (w *Widget) SetConsensus(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.consensus, txt, opts...)
+}
+
+// SetConsensusVotes resets the widget and sets consensus votes text.
+func (w *Widget) SetConsensusVotes(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.consensusVotes, txt, opts...)
+}
+
+// SetRounds resets the widget and sets the rounds lines.
+func (w *Widget) SetRounds(lines []Line) error {
+	return w.setLines(w.rounds, lines)
+}
+
+// SetMempool resets the widget and sets mempool text.
+func (w *Widget) SetMempool(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.mempool, txt, opts...)
+}
+
+// AddMempoolSize adds the mempool size in transactions and bytes to the widgets.
+func (w *Widget) AddMempoolSize(txs int, bytes int64) error {
+	if err := w.mempoolTxs.Add([]int{txs}); err != nil {
+		return err
+	}
+	return w.mempoolBytes.Add([]int{int(bytes)})
+}
+
+// SetPeerTable resets the widget and sets peer table text.
+func (w *Widget) SetPeerTable(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.peerTable, txt, opts...)
+}
+
+// SetSyncStatus resets the widget and sets the node sync status lines.
+func (w *Widget) SetSyncStatus(lines []Line) error {
+	return w.setLines(w.syncStatus, lines)
+}
+
+// SetThroughput resets the widget and sets transactions throughput text.
+func (w *Widget) SetThroughput(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.throughput, txt, opts...)
+}
+
+// SetActivity resets the widget and sets message and module activity text.
+func (w *Widget) SetActivity(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.activity, txt, opts...)
+}
+
+// SetAccounts resets the widget and sets top accounts text.
+func (w *Widget) SetAccounts(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.accounts, txt, opts...)
+}
+
+// SetFees resets the widget and sets fee statistics text.
+func (w *Widget) SetFees(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.fees, txt, opts...)
+}
+
+// SetEvidence resets the widget and sets the evidence lines.
+func (w *Widget) SetEvidence(lines []Line) error {
+	return w.setLines(w.evidence, lines)
+}
+
+// SetUpgrade resets the widget and sets the scheduled upgrade lines.
+func (w *Widget) SetUpgrade(lines []Line) error {
+	return w.setLines(w.upgrade, lines)
+}
+
+// SetGovernance resets the widget and sets the governance proposals text.
+func (w *Widget) SetGovernance(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.governance, txt, opts...)
+}
+
+// SetGovernanceEvents resets the widget and sets the proposal status change lines.
+func (w *Widget) SetGovernanceEvents(lines []Line) error {
+	return w.setLines(w.governanceEvents, lines)
+}
+
+// SetEconomics resets the widget and sets the staking economics text.
+func (w *Widget) SetEconomics(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.economics, txt, opts...)
+}
+
+// SetWatchList resets the widget and sets the watched addresses balances text.
+func (w *Widget) SetWatchList(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.watchList, txt, opts...)
+}
+
+// SetBalanceChanges resets the widget and sets the watched addresses balance changes text.
+func (w *Widget) SetBalanceChanges(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.balanceChanges, txt, opts...)
+}
+
+// SetValidatorSet resets the widget and sets the validator set changes text.
+func (w *Widget) SetValidatorSet(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.validatorSet, txt, opts...)
+}
+
+// SetAppInfo resets the widget and sets the application info lines.
+func (w *Widget) SetAppInfo(lines []Line) error {
+	return w.setLines(w.appInfo, lines)
+}
+
+// SetBlockIntervals resets the widget and sets the block intervals histogram text.
+func (w *Widget) SetBlockIntervals(txt string, opts ...text.WriteOption) error {
+	return w.setText(w.blockIntervals, txt, opts...)
+}
+
+// Bell rings the terminal bell.
+func (w *Widget) Bell() {
+	_, _ = fmt.Fprint(os.Stdout, "\a")
 }
 
 // AddTransaction adds a new transaction to the widget.
@@ -102,19 +260,19 @@ func (w *Widget) AddTransaction(txt string, opts ...text.WriteOption) error {
 	now := time.Now().Format("2006-01-02 15:04:05")
 	if err := w.transactions.Write(
 		fmt.Sprintf("\n\nNew Transaction (%s)\n", now),
-		text.WriteCellOpts(cell.Bold(), cell.Inverse()),
+		text.WriteCellOpts(cell.FgColor(w.theme.Text), cell.Bold(), cell.Inverse()),
 	); err != nil {
 		return err
 	}
-	return w.transactions.Write(txt, opts...)
+	return w.transactions.Write(txt, w.textOptions(opts...)...)
 }
 
 // AddBlock adds a new block to the widget.
 func (w *Widget) AddBlock(txt string, opts ...text.WriteOption) error {
-	return w.blocks.Write(txt+"\n", opts...)
+	return w.blocks.Write(txt+"\n", w.textOptions(opts...)...)
 }
 
-// SetBlockProgress sets the progress of the block in the widget.
-func (w *Widget) SetBlockProgress(percent int, opts ...donut.Option) error {
-	return w.blockProgress.Percent(percent, opts...)
+// SetBlockProgress sets the round label and the progress of the block in the widget.
+func (w *Widget) SetBlockProgress(label string, percent int) error {
+	return w.blockProgress.Percent(percent, donut.Label(label, cell.FgColor(w.theme.Accent)))
 }
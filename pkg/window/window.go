@@ -0,0 +1,56 @@
+package window
+
+// Window is a fixed size rolling window that keeps the latest values pushed.
+// It is not safe for concurrent use.
+type Window[T any] struct {
+	values []T
+	next   int
+	full   bool
+}
+
+// New creates a new rolling window holding up to size values.
+func New[T any](size int) *Window[T] {
+	if size < 1 {
+		size = 1
+	}
+	return &Window[T]{values: make([]T, size)}
+}
+
+// Push adds a new value to the window, dropping the oldest one if the window is full.
+func (w *Window[T]) Push(v T) {
+	w.values[w.next] = v
+	w.next = (w.next + 1) % len(w.values)
+	if w.next == 0 {
+		w.full = true
+	}
+}
+
+// Len returns the number of values in the window.
+func (w *Window[T]) Len() int {
+	if w.full {
+		return len(w.values)
+	}
+	return w.next
+}
+
+// Size returns the max number of values the window can hold.
+func (w *Window[T]) Size() int {
+	return len(w.values)
+}
+
+// Values returns a copy of the window values sorted from the oldest to the newest.
+func (w *Window[T]) Values() []T {
+	values := make([]T, 0, w.Len())
+	if w.full {
+		values = append(values, w.values[w.next:]...)
+	}
+	return append(values, w.values[:w.next]...)
+}
+
+// Last returns the newest value in the window.
+func (w *Window[T]) Last() (v T, ok bool) {
+	if w.Len() == 0 {
+		return v, false
+	}
+	return w.values[(w.next-1+len(w.values))%len(w.values)], true
+}
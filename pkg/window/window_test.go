@@ -0,0 +1,37 @@
+package window
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		push     []int
+		want     []int
+		wantLast int
+	}{
+		{"empty", 3, nil, []int{}, 0},
+		{"partial", 3, []int{1, 2}, []int{1, 2}, 2},
+		{"full", 3, []int{1, 2, 3}, []int{1, 2, 3}, 3},
+		{"rolled", 3, []int{1, 2, 3, 4, 5}, []int{3, 4, 5}, 5},
+		{"invalid size", 0, []int{1, 2}, []int{2}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := New[int](tt.size)
+			for _, v := range tt.push {
+				w.Push(v)
+			}
+			require.Equal(t, len(tt.want), w.Len())
+			require.Equal(t, tt.want, w.Values())
+
+			last, ok := w.Last()
+			require.Equal(t, len(tt.want) > 0, ok)
+			require.Equal(t, tt.wantLast, last)
+		})
+	}
+}
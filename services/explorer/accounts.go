@@ -0,0 +1,198 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gex/pkg/cosmostx"
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// accountsWindow is the number of latest blocks used to rank the accounts.
+	accountsWindow = 100
+
+	// accountsTop is the number of accounts listed for each ranking.
+	accountsTop = 10
+)
+
+// accountActivity holds the transactions activity of an account.
+type accountActivity struct {
+	address string
+	txs     int
+	gas     int64
+	fees    sdk.Coins
+}
+
+// merge returns the sum of both accounts activity.
+// The fees are left unchanged if their sum overflows.
+func (a accountActivity) merge(b accountActivity) accountActivity {
+	a.txs += b.txs
+	a.gas += b.gas
+	if fees, ok := addCoins(a.fees, b.fees...); ok {
+		a.fees = fees
+	}
+	return a
+}
+
+// blockAccounts holds the senders and fee payers activity of a block.
+type blockAccounts struct {
+	senders map[string]accountActivity
+	payers  map[string]accountActivity
+}
+
+// accounts tracks the most active accounts over a rolling window of blocks.
+type accounts struct {
+	sync.RWMutex
+	blocks *window.Window[blockAccounts]
+}
+
+// newAccounts creates a new accounts tracker.
+func newAccounts(size int) *accounts {
+	return &accounts{blocks: window.New[blockAccounts](size)}
+}
+
+// txAccounts returns the transaction senders and the fee payer.
+// The senders are the signers from the tx acc_seq event attributes, which the
+// chain already encodes to bech32, and the senders of the message events
+// emitted by the baseapp, which carry the message action. The message events
+// emitted by the modules are skipped since their sender may be a module account.
+// The fee payer comes from the tx fee_payer event attribute, the decoded tx
+// fee payer or the first signer.
+func txAccounts(events []abci.Event, tx cosmostx.Tx) (senders []string, payer string) {
+	seen := make(map[string]bool)
+	addSender := func(address string) {
+		if address != "" && !seen[address] {
+			seen[address] = true
+			senders = append(senders, address)
+		}
+	}
+	for _, event := range events {
+		switch event.Type {
+		case sdk.EventTypeTx:
+			if accSeq, ok := eventAttribute(event, sdk.AttributeKeyAccountSequence); ok {
+				address, _, _ := strings.Cut(accSeq, "/")
+				addSender(address)
+			}
+			if feePayer, ok := eventAttribute(event, sdk.AttributeKeyFeePayer); ok && payer == "" {
+				payer = feePayer
+			}
+		case sdk.EventTypeMessage:
+			if _, ok := eventAttribute(event, sdk.AttributeKeyAction); ok {
+				sender, _ := eventAttribute(event, sdk.AttributeKeySender)
+				addSender(sender)
+			}
+		}
+	}
+	if payer == "" {
+		payer = tx.Payer
+	}
+	if payer == "" && len(senders) > 0 {
+		payer = senders[0]
+	}
+	return senders, payer
+}
+
+// add adds the senders and fee payers activity of a new block.
+func (a *accounts) add(block types.EventDataNewBlock) {
+	current := blockAccounts{
+		senders: make(map[string]accountActivity),
+		payers:  make(map[string]accountActivity),
+	}
+	results := block.ResultFinalizeBlock.TxResults
+	for i, bz := range block.Block.Txs {
+		if i >= len(results) {
+			break
+		}
+		tx, err := cosmostx.Decode(bz)
+		if err != nil {
+			continue
+		}
+
+		var (
+			activity       = accountActivity{txs: 1, gas: results[i].GasUsed, fees: tx.Fee}
+			senders, payer = txAccounts(results[i].Events, tx)
+		)
+		for _, sender := range senders {
+			current.senders[sender] = current.senders[sender].merge(activity)
+		}
+		if payer != "" {
+			current.payers[payer] = current.payers[payer].merge(activity)
+		}
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	a.blocks.Push(current)
+}
+
+// rank returns the accounts activity sorted by the number of transactions and gas.
+func rank(activities map[string]accountActivity) []accountActivity {
+	ranked := make([]accountActivity, 0, len(activities))
+	for address, activity := range activities {
+		activity.address = address
+		ranked = append(ranked, activity)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].txs != ranked[j].txs {
+			return ranked[i].txs > ranked[j].txs
+		}
+		if ranked[i].gas != ranked[j].gas {
+			return ranked[i].gas > ranked[j].gas
+		}
+		return ranked[i].address < ranked[j].address
+	})
+	if len(ranked) > accountsTop {
+		ranked = ranked[:accountsTop]
+	}
+	return ranked
+}
+
+// String returns the top senders and fee payers tables.
+func (a *accounts) String() string {
+	a.RLock()
+	defer a.RUnlock()
+
+	var (
+		senders = make(map[string]accountActivity)
+		payers  = make(map[string]accountActivity)
+	)
+	for _, block := range a.blocks.Values() {
+		for address, activity := range block.senders {
+			senders[address] = senders[address].merge(activity)
+		}
+		for address, activity := range block.payers {
+			payers[address] = payers[address].merge(activity)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "last %d blocks\n", a.blocks.Len())
+	for _, ranking := range []struct {
+		title      string
+		activities map[string]accountActivity
+	}{
+		{"SENDERS", senders},
+		{"FEE PAYERS", payers},
+	} {
+		fmt.Fprintf(&b, "\n%-45s %6s %15s %s\n", ranking.title, "TXS", "GAS", "FEES")
+		for _, activity := range rank(ranking.activities) {
+			fmt.Fprintf(
+				&b,
+				"%-45s %6d %15s %s\n",
+				activity.address,
+				activity.txs,
+				number.WithComma(activity.gas),
+				activity.fees,
+			)
+		}
+	}
+	return b.String()
+}
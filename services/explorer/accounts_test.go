@@ -0,0 +1,132 @@
+package explorer
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gex/pkg/cosmostx"
+)
+
+// testEvent creates an event from the attributes key and value pairs.
+func testEvent(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func TestTxAccounts(t *testing.T) {
+	const (
+		delegator    = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+		grantee      = "cosmos1zg69v7ys40x77y352eufp27daufrg4nc4sk6pu"
+		distribution = "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"
+		feeCollector = "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta"
+	)
+	// Events of a reward withdrawal, where the bank module emits a message event
+	// with the distribution module account as sender.
+	withdrawEvents := []abci.Event{
+		testEvent("coin_spent", "spender", delegator, "amount", "200stake"),
+		testEvent("coin_received", "receiver", feeCollector, "amount", "200stake"),
+		testEvent("transfer", "recipient", feeCollector, "sender", delegator, "amount", "200stake"),
+		testEvent("message", "sender", delegator),
+		testEvent("tx", "fee", "200stake", "fee_payer", delegator),
+		testEvent("tx", "acc_seq", delegator+"/12"),
+		testEvent("tx", "signature", "c2lnbmF0dXJl"),
+		testEvent(
+			"message",
+			"action", "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+			"sender", delegator,
+			"module", "distribution",
+		),
+		testEvent("coin_spent", "spender", distribution, "amount", "1500stake"),
+		testEvent("coin_received", "receiver", delegator, "amount", "1500stake"),
+		testEvent("transfer", "recipient", delegator, "sender", distribution, "amount", "1500stake"),
+		testEvent("message", "sender", distribution),
+		testEvent("withdraw_rewards", "amount", "1500stake", "validator", "cosmosvaloper1", "delegator", delegator),
+	}
+
+	tests := []struct {
+		name        string
+		events      []abci.Event
+		tx          cosmostx.Tx
+		wantSenders []string
+		wantPayer   string
+	}{
+		{
+			name:        "module account senders are skipped",
+			events:      withdrawEvents,
+			wantSenders: []string{delegator},
+			wantPayer:   delegator,
+		},
+		{
+			name: "multiple signers",
+			events: []abci.Event{
+				testEvent("tx", "acc_seq", delegator+"/12"),
+				testEvent("tx", "acc_seq", grantee+"/3"),
+				testEvent("message", "action", "/cosmos.bank.v1beta1.MsgMultiSend", "sender", delegator),
+				testEvent("message", "sender", grantee),
+			},
+			wantSenders: []string{delegator, grantee},
+			wantPayer:   delegator,
+		},
+		{
+			name: "baseapp message sender without signer event",
+			events: []abci.Event{
+				testEvent("message", "action", "/cosmos.bank.v1beta1.MsgSend", "sender", delegator, "module", "bank"),
+				testEvent("message", "sender", distribution),
+			},
+			wantSenders: []string{delegator},
+			wantPayer:   delegator,
+		},
+		{
+			name: "decoded tx fee payer",
+			events: []abci.Event{
+				testEvent("tx", "acc_seq", delegator+"/12"),
+			},
+			tx:          cosmostx.Tx{Payer: grantee},
+			wantSenders: []string{delegator},
+			wantPayer:   grantee,
+		},
+		{
+			name: "no events",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			senders, payer := txAccounts(tt.events, tt.tx)
+			require.Equal(t, tt.wantSenders, senders)
+			require.Equal(t, tt.wantPayer, payer)
+		})
+	}
+}
+
+func TestAccountActivityMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a    accountActivity
+		b    accountActivity
+		want accountActivity
+	}{
+		{
+			name: "sum",
+			a:    accountActivity{txs: 1, gas: 100, fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+			b:    accountActivity{txs: 2, gas: 50, fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+			want: accountActivity{txs: 3, gas: 150, fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 15))},
+		},
+		{
+			name: "fees overflow",
+			a:    accountActivity{txs: 1, gas: 100, fees: sdk.NewCoins(sdk.NewCoin("stake", maxAmount))},
+			b:    accountActivity{txs: 1, gas: 100, fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			want: accountActivity{txs: 2, gas: 200, fees: sdk.NewCoins(sdk.NewCoin("stake", maxAmount))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.a.merge(tt.b))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/cosmostx"
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// activityWindow is the number of latest blocks used to compute the activity breakdown.
+	activityWindow = 100
+
+	// activityTop is the number of entries listed for each activity breakdown.
+	activityTop = 10
+)
+
+// blockActivity holds the message types, event types and modules counts of a block.
+type blockActivity struct {
+	messages map[string]int
+	events   map[string]int
+	modules  map[string]int
+}
+
+// activity tracks the message and module activity over a rolling window of blocks.
+type activity struct {
+	sync.RWMutex
+	blocks *window.Window[blockActivity]
+}
+
+// newActivity creates a new activity tracker.
+func newActivity(size int) *activity {
+	return &activity{blocks: window.New[blockActivity](size)}
+}
+
+// add adds the activity of a new block from its transactions and their results.
+// The modules are identified by the module attribute of the message events.
+func (a *activity) add(block types.EventDataNewBlock) {
+	current := blockActivity{
+		messages: make(map[string]int),
+		events:   make(map[string]int),
+		modules:  make(map[string]int),
+	}
+	for _, bz := range block.Block.Txs {
+		tx, err := cosmostx.Decode(bz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.Messages {
+			current.messages[msg]++
+		}
+	}
+	for _, result := range block.ResultFinalizeBlock.TxResults {
+		for _, event := range result.Events {
+			current.events[event.Type]++
+			if module, ok := eventAttribute(event, "module"); ok && event.Type == "message" {
+				current.modules[module]++
+			}
+		}
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	a.blocks.Push(current)
+}
+
+// eventAttribute returns the value of the event attribute key.
+func eventAttribute(event abci.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// String returns the message types, event types and modules histograms.
+func (a *activity) String() string {
+	a.RLock()
+	defer a.RUnlock()
+
+	var (
+		messages = make(map[string]int)
+		events   = make(map[string]int)
+		modules  = make(map[string]int)
+	)
+	for _, block := range a.blocks.Values() {
+		for k, v := range block.messages {
+			messages[k] += v
+		}
+		for k, v := range block.events {
+			events[k] += v
+		}
+		for k, v := range block.modules {
+			modules[k] += v
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "last %d blocks\n", a.blocks.Len())
+	for _, breakdown := range []struct {
+		title  string
+		counts map[string]int
+	}{
+		{"MESSAGE TYPES", messages},
+		{"MODULES", modules},
+		{"EVENT TYPES", events},
+	} {
+		buckets := sortedBuckets(breakdown.counts)
+		if len(buckets) > activityTop {
+			buckets = buckets[:activityTop]
+		}
+		fmt.Fprintf(&b, "\n%s\n%s", breakdown.title, formatHistogram(buckets, 40))
+	}
+	return b.String()
+}
@@ -0,0 +1,102 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ignite/gex/pkg/client"
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/widget"
+)
+
+// appInfoInterval is the interval between the application info queries.
+const appInfoInterval = 5 * time.Second
+
+// versionChange holds an application or node version change noticed during the session.
+type versionChange struct {
+	at       time.Time
+	name     string
+	previous string
+	current  string
+}
+
+// appInfo tracks the ABCI application info and the node version.
+type appInfo struct {
+	sync.RWMutex
+	info      client.AppInfo
+	err       error
+	updatedAt time.Time
+	changes   []versionChange
+}
+
+// set sets the application info and records the version changes since the previous info.
+func (a *appInfo) set(info client.AppInfo, err error, now time.Time) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.err = err
+	if err != nil {
+		return
+	}
+	if !a.updatedAt.IsZero() {
+		for _, version := range []struct {
+			name              string
+			previous, current string
+		}{
+			{"app version", a.info.ABCI.Version, info.ABCI.Version},
+			{"app protocol version", fmt.Sprint(a.info.ABCI.AppVersion), fmt.Sprint(info.ABCI.AppVersion)},
+			{"node version", a.info.NodeInfo.Version, info.NodeInfo.Version},
+		} {
+			if version.previous != version.current {
+				a.changes = append(a.changes, versionChange{now, version.name, version.previous, version.current})
+			}
+		}
+	}
+	a.info = info
+	a.updatedAt = now
+}
+
+// lines returns the application info and the version changes as alert lines.
+func (a *appInfo) lines() []widget.Line {
+	a.RLock()
+	defer a.RUnlock()
+
+	if a.err != nil {
+		return []widget.Line{{Text: fmt.Sprintf("application info not reachable: %s", a.err)}}
+	}
+
+	var (
+		abci     = a.info.ABCI
+		nodeInfo = a.info.NodeInfo
+		lines    = []widget.Line{
+			{Text: fmt.Sprintf("application: %s", abci.Data)},
+			{Text: fmt.Sprintf("version: %s", abci.Version)},
+			{Text: fmt.Sprintf("app version: %d", abci.AppVersion)},
+			{Text: fmt.Sprintf("last block height: %s", number.WithComma(abci.LastBlockHeight))},
+			{Text: fmt.Sprintf("last app hash: %X", abci.LastBlockAppHash)},
+			{Text: fmt.Sprintf("\ncometbft version: %s", nodeInfo.Version)},
+			{Text: fmt.Sprintf(
+				"protocol versions: p2p %d, block %d, app %d",
+				nodeInfo.ProtocolVersion.P2P,
+				nodeInfo.ProtocolVersion.Block,
+				nodeInfo.ProtocolVersion.App,
+			)},
+			{Text: fmt.Sprintf("updated at: %s", a.updatedAt.Format(time.DateTime))},
+		}
+	)
+	for i := len(a.changes) - 1; i >= 0; i-- {
+		change := a.changes[i]
+		lines = append(lines, widget.Line{
+			Text: fmt.Sprintf(
+				"\n⚠ %s %s changed from %s to %s",
+				change.at.Format(time.DateTime),
+				change.name,
+				change.previous,
+				change.current,
+			),
+			Alert: true,
+		})
+	}
+	return lines
+}
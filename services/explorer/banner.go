@@ -0,0 +1,40 @@
+package explorer
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/gex/pkg/widget"
+)
+
+// banner owns the banner shown on every page, composed of the active alerts.
+// The alerts are collected and written under the lock so concurrent updates
+// never overwrite a newer banner with a stale one.
+type banner struct {
+	sync.Mutex
+	w       *widget.Widget
+	upgrade *upgrade
+	halt    *halt
+	gov     *governance
+}
+
+// newBanner creates a new banner for the alert trackers.
+func newBanner(w *widget.Widget, u *upgrade, h *halt, g *governance) *banner {
+	return &banner{w: w, upgrade: u, halt: h, gov: g}
+}
+
+// update writes the banner with the current alerts.
+func (b *banner) update() error {
+	b.Lock()
+	defer b.Unlock()
+
+	now := time.Now()
+	alerts := make([]string, 0, 3)
+	for _, alert := range []string{b.halt.banner(now), b.upgrade.banner(), b.gov.banner(now)} {
+		if alert != "" {
+			alerts = append(alerts, alert)
+		}
+	}
+	return b.w.SetBanner(strings.Join(alerts, " | "))
+}
@@ -0,0 +1,131 @@
+package explorer
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+// DefaultBlockIntervalWindow is the default number of latest block intervals of the histogram.
+const DefaultBlockIntervalWindow = 1000
+
+// defaultBlockIntervalBuckets are the histogram bucket upper bounds used when none are configured.
+var defaultBlockIntervalBuckets = []time.Duration{
+	500 * time.Millisecond,
+	1 * time.Second,
+	2 * time.Second,
+	3 * time.Second,
+	5 * time.Second,
+	7 * time.Second,
+	10 * time.Second,
+	15 * time.Second,
+	30 * time.Second,
+}
+
+// blockIntervals tracks the intervals between the block header times.
+type blockIntervals struct {
+	sync.RWMutex
+	buckets    []time.Duration
+	lastHeight int64
+	lastTime   time.Time
+	intervals  *window.Window[time.Duration]
+}
+
+// newBlockIntervals creates a new block intervals tracker with the sorted and deduplicated
+// bucket upper bounds, or the default buckets if none.
+func newBlockIntervals(size int, buckets []time.Duration) *blockIntervals {
+	if len(buckets) == 0 {
+		buckets = defaultBlockIntervalBuckets
+	}
+	buckets = append([]time.Duration(nil), buckets...)
+	slices.Sort(buckets)
+	return &blockIntervals{
+		buckets:   slices.Compact(buckets),
+		intervals: window.New[time.Duration](size),
+	}
+}
+
+// add adds a new block header time. The interval is only recorded from the previous
+// height, since the interval after missed blocks spans several blocks.
+func (b *blockIntervals) add(height int64, blockTime time.Time) {
+	b.Lock()
+	defer b.Unlock()
+	if height <= b.lastHeight {
+		return
+	}
+	if b.lastHeight > 0 && height == b.lastHeight+1 {
+		b.intervals.Push(blockTime.Sub(b.lastTime))
+	}
+	b.lastHeight, b.lastTime = height, blockTime
+}
+
+// bucketCounts returns the number of intervals of each bucket, a bucket counting the
+// intervals lower than its upper bound and greater or equal to the previous one.
+// The last count is the overflow bucket of the intervals greater or equal to the last bound.
+func bucketCounts(buckets, intervals []time.Duration) []int {
+	counts := make([]int, len(buckets)+1)
+	for _, interval := range intervals {
+		counts[sort.Search(len(buckets), func(i int) bool { return interval < buckets[i] })]++
+	}
+	return counts
+}
+
+// bucketLabel returns the label of the bucket index, the last index being the overflow bucket.
+// Caller must hold the lock.
+func (b *blockIntervals) bucketLabel(i int) string {
+	switch {
+	case i == 0:
+		return fmt.Sprintf("< %s", b.buckets[0])
+	case i == len(b.buckets):
+		return fmt.Sprintf(">= %s", b.buckets[i-1])
+	default:
+		return fmt.Sprintf("%s-%s", b.buckets[i-1], b.buckets[i])
+	}
+}
+
+// String returns the block interval statistics and histogram.
+func (b *blockIntervals) String() string {
+	b.RLock()
+	defer b.RUnlock()
+
+	intervals := b.intervals.Values()
+	if len(intervals) == 0 {
+		return "waiting for blocks"
+	}
+
+	var (
+		counts  = bucketCounts(b.buckets, intervals)
+		seconds = make([]float64, len(intervals))
+		total   time.Duration
+	)
+	for i, interval := range intervals {
+		seconds[i] = interval.Seconds()
+		total += interval
+	}
+
+	buckets := make([]histogramBucket, len(counts))
+	for i, count := range counts {
+		buckets[i] = histogramBucket{label: b.bucketLabel(i), count: count}
+	}
+
+	var s strings.Builder
+	fmt.Fprintf(&s, "last %s blocks\n", number.WithComma(int64(len(intervals))))
+	fmt.Fprintf(
+		&s,
+		"min: %.2fs avg: %.2fs p50: %.2fs p90: %.2fs p99: %.2fs max: %.2fs\n\n",
+		number.Percentile(seconds, 0),
+		(total / time.Duration(len(intervals))).Seconds(),
+		number.Percentile(seconds, 50),
+		number.Percentile(seconds, 90),
+		number.Percentile(seconds, 99),
+		number.Percentile(seconds, 100),
+	)
+	fmt.Fprint(&s, formatHistogram(buckets, 12))
+	return s.String()
+}
@@ -0,0 +1,107 @@
+package explorer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketCounts(t *testing.T) {
+	buckets := []time.Duration{time.Second, 2 * time.Second, 5 * time.Second}
+	tests := []struct {
+		name      string
+		intervals []time.Duration
+		want      []int
+	}{
+		{"no intervals", nil, []int{0, 0, 0, 0}},
+		{"lower than the first bound", []time.Duration{0, 999 * time.Millisecond}, []int{2, 0, 0, 0}},
+		{"bound belongs to the next bucket", []time.Duration{time.Second, 2 * time.Second}, []int{0, 1, 1, 0}},
+		{"inside a bucket", []time.Duration{1500 * time.Millisecond, 4 * time.Second}, []int{0, 1, 1, 0}},
+		{"last bound overflows", []time.Duration{5 * time.Second}, []int{0, 0, 0, 1}},
+		{"overflow", []time.Duration{6 * time.Second, time.Hour}, []int{0, 0, 0, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, bucketCounts(buckets, tt.intervals))
+		})
+	}
+}
+
+func TestNewBlockIntervals(t *testing.T) {
+	tests := []struct {
+		name       string
+		buckets    []time.Duration
+		want       []time.Duration
+		wantLabels []string
+	}{
+		{
+			name:       "sorted and deduplicated",
+			buckets:    []time.Duration{5 * time.Second, time.Second, 5 * time.Second},
+			want:       []time.Duration{time.Second, 5 * time.Second},
+			wantLabels: []string{"< 1s", "1s-5s", ">= 5s"},
+		},
+		{
+			name:       "single bucket",
+			buckets:    []time.Duration{time.Second},
+			want:       []time.Duration{time.Second},
+			wantLabels: []string{"< 1s", ">= 1s"},
+		},
+		{
+			name: "default buckets",
+			want: defaultBlockIntervalBuckets,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBlockIntervals(10, tt.buckets)
+			require.Equal(t, tt.want, b.buckets)
+			for i, label := range tt.wantLabels {
+				require.Equal(t, label, b.bucketLabel(i))
+			}
+		})
+	}
+}
+
+func TestBlockIntervalsAdd(t *testing.T) {
+	type block struct {
+		height int64
+		time   time.Duration
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		blocks []block
+		want   []time.Duration
+	}{
+		{
+			name:   "single block",
+			blocks: []block{{10, 0}},
+			want:   []time.Duration{},
+		},
+		{
+			name:   "consecutive heights",
+			blocks: []block{{10, 0}, {11, time.Second}, {12, 3 * time.Second}},
+			want:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "missed blocks are skipped",
+			blocks: []block{{10, 0}, {11, time.Second}, {14, 10 * time.Second}, {15, 12 * time.Second}},
+			want:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:   "older heights are ignored",
+			blocks: []block{{10, 0}, {11, time.Second}, {11, time.Second}, {9, 0}, {12, 2 * time.Second}},
+			want:   []time.Duration{time.Second, time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBlockIntervals(10, nil)
+			for _, block := range tt.blocks {
+				b.add(block.height, start.Add(block.time))
+			}
+			require.Equal(t, tt.want, b.intervals.Values())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+// blockSizeWindow is the number of latest blocks used to compute the block size statistics.
+const blockSizeWindow = 100
+
+// blockSizes tracks the encoded block sizes over a rolling window of blocks.
+type blockSizes struct {
+	sync.RWMutex
+	window   *window.Window[int64]
+	maxBytes int64
+}
+
+// newBlockSizes creates a new block sizes tracker.
+func newBlockSizes(size int) *blockSizes {
+	return &blockSizes{window: window.New[int64](size)}
+}
+
+// add adds a new block size in bytes.
+func (s *blockSizes) add(size int64) {
+	s.Lock()
+	defer s.Unlock()
+	s.window.Push(size)
+}
+
+// setMaxBytes sets the max block size from the consensus parameters.
+// A negative value means the max block size allowed by CometBFT.
+func (s *blockSizes) setMaxBytes(maxBytes int64) {
+	if maxBytes < 0 {
+		maxBytes = types.MaxBlockSizeBytes
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.maxBytes = maxBytes
+}
+
+// String returns the max block size with the average and max size of the latest blocks.
+func (s *blockSizes) String() string {
+	s.RLock()
+	defer s.RUnlock()
+
+	var total, largest int64
+	sizes := s.window.Values()
+	for _, size := range sizes {
+		total += size
+		if size > largest {
+			largest = size
+		}
+	}
+	var average int64
+	if len(sizes) > 0 {
+		average = total / int64(len(sizes))
+	}
+
+	return fmt.Sprintf(
+		"%s\navg: %s (%s)\nmax: %s (%s)",
+		number.ByteCountDecimal(s.maxBytes),
+		number.ByteCountDecimal(average),
+		number.Percentage(average, s.maxBytes),
+		number.ByteCountDecimal(largest),
+		number.Percentage(largest, s.maxBytes),
+	)
+}
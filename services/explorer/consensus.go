@@ -0,0 +1,256 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/client"
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/widget"
+)
+
+const (
+	voteReceived    = "✔"
+	voteNotReceived = "-"
+)
+
+var (
+	RoundStepPropose   = strings.ToUpper("RoundStepPropose")
+	RoundStepPreVote   = strings.ToUpper("RoundStepPrevote")
+	RoundStepPreCommit = strings.ToUpper("RoundStepPrecommit")
+	RoundStepCommit    = strings.ToUpper("RoundStepCommit")
+	RoundStepNewHeight = strings.ToUpper("RoundStepNewHeight")
+)
+
+// consensus tracks the consensus round state and the votes of the current round.
+// The votes are identified by index only when the validator set is the one of the current height.
+type consensus struct {
+	sync.RWMutex
+	height           int64
+	round            int32
+	step             string
+	proposer         string
+	validators       []*types.Validator
+	validatorsHeight int64
+	prevotes         map[int32]bool
+	precommits       map[int32]bool
+	monikers         *monikers
+	err              error
+
+	// render serializes the widget updates so a stale state never overwrites a newer one.
+	render sync.Mutex
+}
+
+// newConsensus creates a new consensus round state tracker.
+func newConsensus(monikers *monikers) *consensus {
+	return &consensus{
+		prevotes:   make(map[int32]bool),
+		precommits: make(map[int32]bool),
+		monikers:   monikers,
+	}
+}
+
+// setValidators sets the validator set of the height used to identify the votes by index.
+// The set is ignored if the round moved to another height in the meantime.
+func (c *consensus) setValidators(height int64, validators []*types.Validator) {
+	c.Lock()
+	defer c.Unlock()
+	if height != c.height {
+		return
+	}
+	c.validators = validators
+	c.validatorsHeight = height
+}
+
+// missingValidators returns the current height and true if its validator set is not set yet.
+func (c *consensus) missingValidators() (int64, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	return c.height, c.height > 0 && c.validatorsHeight != c.height
+}
+
+// roundValidators returns the validator set of the current height, nil if not set yet.
+// Caller must hold the lock.
+func (c *consensus) roundValidators() []*types.Validator {
+	if c.validatorsHeight != c.height {
+		return nil
+	}
+	return c.validators
+}
+
+// setRound moves the tracker to the height and round, resetting the votes if they changed.
+// Caller must hold the lock.
+func (c *consensus) setRound(height int64, round int32, step string) {
+	if c.height != height || c.round != round {
+		c.prevotes = make(map[int32]bool)
+		c.precommits = make(map[int32]bool)
+	}
+	if c.height != height {
+		c.proposer = ""
+	}
+	c.height, c.round, c.step = height, round, step
+}
+
+// setRoundStep sets the round state from a new round step event.
+func (c *consensus) setRoundStep(state types.EventDataRoundState) {
+	c.Lock()
+	defer c.Unlock()
+	if state.Height < c.height {
+		return
+	}
+	c.setRound(state.Height, state.Round, state.Step)
+}
+
+// setRoundState sets the round state and votes from the node consensus state.
+// The round state is kept on error, since the websocket events keep updating it.
+func (c *consensus) setRoundState(state client.RoundState, err error) {
+	c.Lock()
+	defer c.Unlock()
+	c.err = err
+	if err != nil {
+		return
+	}
+	if state.Height < c.height || (state.Height == c.height && state.Round < c.round) {
+		return
+	}
+	c.setRound(state.Height, state.Round, state.Step.String())
+	c.proposer = state.Proposer.Address.String()
+	for i, received := range state.Prevotes {
+		if received {
+			c.prevotes[int32(i)] = true
+		}
+	}
+	for i, received := range state.Precommits {
+		if received {
+			c.precommits[int32(i)] = true
+		}
+	}
+}
+
+// addVote adds a vote of the current round.
+func (c *consensus) addVote(vote *types.Vote) {
+	c.Lock()
+	defer c.Unlock()
+	if vote == nil || vote.Height != c.height || vote.Round != c.round {
+		return
+	}
+	switch vote.Type {
+	case cmtproto.PrevoteType:
+		c.prevotes[vote.ValidatorIndex] = true
+	case cmtproto.PrecommitType:
+		c.precommits[vote.ValidatorIndex] = true
+	case cmtproto.UnknownType, cmtproto.ProposalType:
+	}
+}
+
+// votedPower returns the voting power of the received votes and the total voting power.
+// Caller must hold the lock.
+func (c *consensus) votedPower(votes map[int32]bool) (voted, total int64) {
+	for i, validator := range c.roundValidators() {
+		total += validator.VotingPower
+		if votes[int32(i)] {
+			voted += validator.VotingPower
+		}
+	}
+	return voted, total
+}
+
+// progress returns the round label and the voted power percentage of the current step.
+func (c *consensus) progress() (string, int) {
+	c.RLock()
+	defer c.RUnlock()
+
+	label := fmt.Sprintf("%d/%d %s", c.height, c.round, strings.TrimPrefix(c.step, "RoundStep"))
+
+	var votes map[int32]bool
+	switch strings.ToUpper(c.step) {
+	case RoundStepPreVote:
+		votes = c.prevotes
+	case RoundStepPreCommit, RoundStepCommit:
+		votes = c.precommits
+	case RoundStepNewHeight:
+		return label, 100
+	default:
+		return label, 0
+	}
+
+	voted, total := c.votedPower(votes)
+	if total == 0 {
+		return label, 0
+	}
+	// Float math since the voting powers can overflow once multiplied.
+	return label, int(float64(voted) * 100 / float64(total))
+}
+
+// String returns the consensus round state summary.
+func (c *consensus) String() string {
+	c.RLock()
+	defer c.RUnlock()
+
+	var (
+		prevoted, total = c.votedPower(c.prevotes)
+		precommitted, _ = c.votedPower(c.precommits)
+		validators      = len(c.roundValidators())
+		proposer        = "-"
+		b               strings.Builder
+	)
+	if c.proposer != "" {
+		proposer = c.monikers.name(c.proposer)
+	}
+	if c.err != nil {
+		fmt.Fprintf(&b, "consensus state not reachable: %s\n\n", c.err)
+	}
+
+	fmt.Fprintf(&b, "height: %d\n", c.height)
+	fmt.Fprintf(&b, "round: %d\n", c.round)
+	fmt.Fprintf(&b, "step: %s\n", c.step)
+	fmt.Fprintf(&b, "proposer: %s\n\n", proposer)
+	fmt.Fprintf(&b, "prevotes: %s (%d/%d)\n", number.Percentage(prevoted, total), len(c.prevotes), validators)
+	fmt.Fprintf(&b, "precommits: %s (%d/%d)\n", number.Percentage(precommitted, total), len(c.precommits), validators)
+	return b.String()
+}
+
+// votes returns the validator votes table of the current round.
+func (c *consensus) votes() string {
+	c.RLock()
+	defer c.RUnlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-40s %15s %9s %9s\n", "VALIDATOR", "POWER", "PREVOTE", "PRECOMMIT")
+	for i, validator := range c.roundValidators() {
+		prevote, precommit := voteNotReceived, voteNotReceived
+		if c.prevotes[int32(i)] {
+			prevote = voteReceived
+		}
+		if c.precommits[int32(i)] {
+			precommit = voteReceived
+		}
+		fmt.Fprintf(
+			&b,
+			"%-40.40s %15s %9s %9s\n",
+			c.monikers.name(validator.Address.String()),
+			number.WithComma(validator.VotingPower),
+			prevote,
+			precommit,
+		)
+	}
+	return b.String()
+}
+
+// updateConsensus updates the consensus widgets with the current round state.
+func updateConsensus(w *widget.Widget, c *consensus) error {
+	c.render.Lock()
+	defer c.render.Unlock()
+
+	if err := w.SetBlockProgress(c.progress()); err != nil {
+		return err
+	}
+	if err := w.SetConsensus(c.String()); err != nil {
+		return err
+	}
+	return w.SetConsensusVotes(c.votes())
+}
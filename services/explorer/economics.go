@@ -0,0 +1,94 @@
+package explorer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/math"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/ignite/gex/pkg/client"
+	"github.com/ignite/gex/pkg/number"
+)
+
+// economicsRefreshInterval is the number of blocks between staking economics queries.
+const economicsRefreshInterval = 10
+
+// economics holds the staking economics of the chain.
+type economics struct {
+	sync.RWMutex
+	economics client.Economics
+	err       error
+}
+
+// set sets the staking economics.
+func (e *economics) set(economics client.Economics, err error) {
+	e.Lock()
+	defer e.Unlock()
+	e.economics = economics
+	e.err = err
+}
+
+// formatAmount formats a token amount with thousands separators when it fits in an int64.
+func formatAmount(amount math.Int) string {
+	if amount.IsNil() {
+		return "0"
+	}
+	if !amount.IsInt64() {
+		return amount.String()
+	}
+	return number.WithComma(amount.Int64())
+}
+
+// formatDec formats a decimal ratio as a percentage.
+func formatDec(dec math.LegacyDec) string {
+	if dec.IsNil() {
+		return "-"
+	}
+	return formatDecPercentage(dec.String())
+}
+
+// String returns the staking denom supply, the staking pool and the mint and community pool amounts.
+func (e *economics) String() string {
+	e.RLock()
+	defer e.RUnlock()
+
+	if e.err != nil {
+		return fmt.Sprintf("staking economics not reachable: %s", e.err)
+	}
+
+	var (
+		ec     = e.economics
+		supply = toFloat(ec.Supply.Amount.String())
+		bonded = toFloat(ec.BondedTokens.String())
+		b      strings.Builder
+	)
+	fmt.Fprintf(&b, "staking denom: %s\n", ec.BondDenom)
+	fmt.Fprintf(&b, "total supply: %s\n", formatAmount(ec.Supply.Amount))
+	fmt.Fprintf(&b, "bonded: %s\n", formatAmount(ec.BondedTokens))
+	fmt.Fprintf(&b, "not bonded: %s\n", formatAmount(ec.NotBondedTokens))
+	fmt.Fprintf(&b, "bonded ratio: %.2f%%\n", ratio(bonded, supply))
+
+	if !slices.Contains(ec.Unavailable, minttypes.ModuleName) {
+		fmt.Fprintf(&b, "\ninflation: %s\n", formatDec(ec.Inflation))
+		fmt.Fprintf(&b, "annual provisions: %s %s\n", formatAmount(ec.AnnualProvisions.TruncateInt()), ec.BondDenom)
+	}
+
+	if !slices.Contains(ec.Unavailable, distrtypes.ModuleName) {
+		fmt.Fprint(&b, "\ncommunity pool:\n")
+		if ec.CommunityPool.IsZero() {
+			fmt.Fprint(&b, "  empty\n")
+		}
+		for _, coin := range ec.CommunityPool {
+			fmt.Fprintf(&b, "  %s %s\n", formatAmount(coin.Amount.TruncateInt()), coin.Denom)
+		}
+	}
+
+	if len(ec.Unavailable) > 0 {
+		fmt.Fprintf(&b, "\nmodules not reachable: %s\n", strings.Join(ec.Unavailable, ", "))
+	}
+	return b.String()
+}
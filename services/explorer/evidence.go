@@ -0,0 +1,96 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/widget"
+)
+
+// evidenceRecord holds a misbehavior evidence committed in a block.
+type evidenceRecord struct {
+	blockHeight int64
+	kind        string
+	height      int64
+	time        time.Time
+	validators  []string
+}
+
+// evidences keeps the history of the evidences committed during the session.
+type evidences struct {
+	sync.RWMutex
+	records  []evidenceRecord
+	monikers *monikers
+}
+
+// newEvidences creates a new evidences history.
+func newEvidences(monikers *monikers) *evidences {
+	return &evidences{monikers: monikers}
+}
+
+// add adds the evidences committed in the block and returns how many were found.
+func (e *evidences) add(block *types.Block) int {
+	records := make([]evidenceRecord, 0, len(block.Evidence.Evidence))
+	for _, ev := range block.Evidence.Evidence {
+		record := evidenceRecord{
+			blockHeight: block.Height,
+			height:      ev.Height(),
+			time:        ev.Time(),
+		}
+		switch ev := ev.(type) {
+		case *types.DuplicateVoteEvidence:
+			record.kind = "duplicate vote"
+			if ev.VoteA != nil {
+				record.validators = []string{ev.VoteA.ValidatorAddress.String()}
+			}
+		case *types.LightClientAttackEvidence:
+			record.kind = "light client attack"
+			for _, validator := range ev.ByzantineValidators {
+				record.validators = append(record.validators, validator.Address.String())
+			}
+		default:
+			record.kind = fmt.Sprintf("unknown %T", ev)
+		}
+		records = append(records, record)
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.records = append(e.records, records...)
+	return len(records)
+}
+
+// lines returns the evidences history from the newest as alert lines.
+func (e *evidences) lines() []widget.Line {
+	e.RLock()
+	defer e.RUnlock()
+
+	if len(e.records) == 0 {
+		return []widget.Line{{Text: "no evidence committed during the session"}}
+	}
+
+	lines := []widget.Line{{Text: fmt.Sprintf("%d evidences committed during the session", len(e.records))}}
+	for i := len(e.records) - 1; i >= 0; i-- {
+		record := e.records[i]
+		validators := make([]string, len(record.validators))
+		for j, address := range record.validators {
+			validators[j] = e.monikers.name(address)
+		}
+		lines = append(lines, widget.Line{
+			Text: fmt.Sprintf(
+				"\n⚠ %s at height %d (%s), committed at height %d\n  validators: %s",
+				record.kind,
+				record.height,
+				record.time.Format(time.DateTime),
+				record.blockHeight,
+				strings.Join(validators, ", "),
+			),
+			Alert: true,
+		})
+	}
+	return lines
+}
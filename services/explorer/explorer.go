@@ -1,15 +1,17 @@
 package explorer
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cometbft/cometbft/libs/json"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/gex/pkg/client"
@@ -22,26 +24,23 @@ const (
 	statusNotConnected = "✖️ not connected"
 )
 
-var (
-	RoundStepPropose   = strings.ToUpper("RoundStepPropose")
-	RoundStepPreVote   = strings.ToUpper("RoundStepPrevote")
-	RoundStepPreCommit = strings.ToUpper("RoundStepPrecommit")
-	RoundStepCommit    = strings.ToUpper("RoundStepCommit")
-	RoundStepNewHeight = strings.ToUpper("RoundStepNewHeight")
-)
-
 // info holds all cross infos.
 type info struct {
 	sync.RWMutex
-	blocks          int64
-	maxGasWanted    int64
-	transactions    int64
-	totalGasWanted  int64
-	lastTxGasWanted int64
+	blocks           int64
+	maxGasWanted     int64
+	transactions     int64
+	totalGasWanted   int64
+	totalGasUsed     int64
+	lastTxGasWanted  int64
+	lastTxGasUsed    int64
+	lastBlockGasUsed int64
 }
 
 // Run runs the explorer view listening to the provided host.
-func Run(ctx context.Context, host string) error {
+func Run(ctx context.Context, host string, opts ...Option) error {
+	o := newOptions(opts...)
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -50,14 +49,44 @@ func Run(ctx context.Context, host string) error {
 		return err
 	}
 
-	w, err := widget.New()
+	var widgetOptions []widget.Option
+	if o.layout != nil {
+		widgetOptions = append(widgetOptions, widget.WithLayout(*o.layout))
+	}
+	if o.theme != nil {
+		widgetOptions = append(widgetOptions, widget.WithTheme(*o.theme))
+	}
+	w, err := widget.New(widgetOptions...)
 	if err != nil {
 		return err
 	}
 
 	var (
-		info  = &info{}
-		start = time.Now()
+		info      = &info{}
+		monikers  = newMonikers()
+		proposers = newProposers(proposerWindow, monikers)
+		uptime    = newUptime(uptimeWindow, monikers)
+		consensus = newConsensus(monikers)
+		rounds    = newRounds(roundsWindow)
+		mempool   = newMempool()
+		peers     = &peers{}
+		syncs     = newSyncStatus(syncWindow)
+		sizes     = newBlockSizes(blockSizeWindow)
+		tps       = newThroughput(throughputWindow)
+		activity  = newActivity(activityWindow)
+		accounts  = newAccounts(accountsWindow)
+		fees      = newFees()
+		evidences = newEvidences(monikers)
+		upgrades  = newUpgrade(upgradeWindow)
+		gov       = newGovernance()
+		economics = &economics{}
+		watchList = newWatchList(o.watchAddresses, watchHistorySize)
+		valSet    = newValidatorSet(validatorSetChangesSize, monikers)
+		appInfo   = &appInfo{}
+		halt      = newHalt(haltWindow)
+		intervals = newBlockIntervals(o.intervalWindow, o.intervalBuckets)
+		banner    = newBanner(w, upgrades, halt, gov)
+		start     = time.Now()
 	)
 
 	errGroup, _ := errgroup.WithContext(ctx)
@@ -66,6 +95,10 @@ func Run(ctx context.Context, host string) error {
 		if err != nil {
 			return err
 		}
+		// The latest block of a node catching up is not the chain tip.
+		if !status.SyncInfo.CatchingUp {
+			halt.seed(status.SyncInfo.LatestBlockHeight, status.SyncInfo.LatestBlockTime)
+		}
 
 		if err := w.SetCurrentNetwork(status.NodeInfo.Network); err != nil {
 			return err
@@ -73,6 +106,24 @@ func Run(ctx context.Context, host string) error {
 		return w.SetMoniker(status.NodeInfo.Moniker)
 	})
 
+	errGroup.Go(func() error {
+		// The balances are pinned to a height so the ones already updated by newer
+		// blocks are not overwritten.
+		height, err := c.LatestBlockHeight(ctx)
+		for _, address := range o.watchAddresses {
+			if err != nil {
+				watchList.set(address, 0, nil, err)
+				continue
+			}
+			balances, err := c.Balances(ctx, address, height)
+			watchList.set(address, height, balances, err)
+		}
+		if err := w.SetWatchList(watchList.String()); err != nil {
+			return err
+		}
+		return w.SetBalanceChanges(watchList.changes())
+	})
+
 	client.Callback(ctx, 1*time.Second, func() error {
 		now := time.Now()
 		if err := w.SetTime(now.Format("2006-01-02\n15:04:05")); err != nil {
@@ -82,18 +133,26 @@ func Run(ctx context.Context, host string) error {
 
 		info.RLock()
 		var (
-			lastTxGasWanted  = info.lastTxGasWanted
-			maxGasWanted     = info.maxGasWanted
-			blocksPerSecond  = 0.0
-			totalGasPerBlock = int64(0)
-			averageGasPerTx  = int64(0)
+			lastTxGasWanted       = info.lastTxGasWanted
+			lastTxGasUsed         = info.lastTxGasUsed
+			lastBlockGasUsed      = info.lastBlockGasUsed
+			maxGasWanted          = info.maxGasWanted
+			totalGasWanted        = info.totalGasWanted
+			totalGasUsed          = info.totalGasUsed
+			blocksPerSecond       = 0.0
+			gasWantedPerBlock     = int64(0)
+			gasUsedPerBlock       = int64(0)
+			averageGasWantedPerTx = int64(0)
+			averageGasUsedPerTx   = int64(0)
 		)
 		if info.blocks > 0 {
-			totalGasPerBlock = info.totalGasWanted / info.blocks
+			gasWantedPerBlock = info.totalGasWanted / info.blocks
+			gasUsedPerBlock = info.totalGasUsed / info.blocks
 			blocksPerSecond = secondsPassed / float64(info.blocks)
 		}
 		if info.transactions > 0 {
-			averageGasPerTx = info.totalGasWanted / info.transactions
+			averageGasWantedPerTx = info.totalGasWanted / info.transactions
+			averageGasUsedPerTx = info.totalGasUsed / info.transactions
 		}
 		info.RUnlock()
 
@@ -101,32 +160,70 @@ func Run(ctx context.Context, host string) error {
 			return err
 		}
 
-		if err := w.SetGasMax(number.WithComma(maxGasWanted)); err != nil {
+		if err := w.SetGasMax(formatBlockGasUtilization(maxGasWanted, lastBlockGasUsed)); err != nil {
 			return err
 		}
 
-		if err := w.SetGasAvgBlock(number.WithComma(totalGasPerBlock)); err != nil {
+		if err := w.SetGasAvgBlock(formatGasUsage(gasWantedPerBlock, gasUsedPerBlock)); err != nil {
 			return err
 		}
 
-		if err := w.SetLatestGas(number.WithComma(lastTxGasWanted)); err != nil {
+		if err := w.SetLatestGas(formatGasEfficiency(lastTxGasWanted, lastTxGasUsed)); err != nil {
 			return err
 		}
 
-		return w.SetGasAvgTransaction(number.WithComma(averageGasPerTx))
+		return w.SetGasAvgTransaction(fmt.Sprintf(
+			"%s\nsession: %s",
+			formatGasUsage(averageGasWantedPerTx, averageGasUsedPerTx),
+			number.Percentage(totalGasUsed, totalGasWanted),
+		))
+	})
+
+	client.Callback(ctx, 1*time.Second, func() error {
+		if halt.check(time.Now()) && o.bell {
+			w.Bell()
+		}
+		return banner.update()
+	})
+
+	c.SyncInfo(ctx, func(info coretypes.SyncInfo, err error) error {
+		now := time.Now()
+		syncs.set(info, err, now)
+		return w.SetSyncStatus(syncs.lines(now))
+	})
+
+	c.AppInfo(ctx, appInfoInterval, func(info client.AppInfo, err error) error {
+		appInfo.set(info, err, time.Now())
+		return w.SetAppInfo(appInfo.lines())
 	})
 
 	c.ConsensusParams(ctx, func(params coretypes.ResultConsensusParams) error {
 		info.Lock()
 		info.maxGasWanted = params.ConsensusParams.Block.MaxGas
 		info.Unlock()
-		return w.SetMaxBlockSize(number.ByteCountDecimal(params.ConsensusParams.Block.MaxBytes))
+		sizes.setMaxBytes(params.ConsensusParams.Block.MaxBytes)
+		return w.SetMaxBlockSize(sizes.String())
 	})
 
 	c.NetInfo(ctx, func(info coretypes.ResultNetInfo) error {
+		peers.set(info.Peers)
+		if err := w.SetPeerTable(peers.String()); err != nil {
+			return err
+		}
 		return w.SetPeers(info.NPeers)
 	})
 
+	c.Mempool(ctx, func(unconfirmed coretypes.ResultUnconfirmedTxs, err error) error {
+		mempool.set(unconfirmed, err, time.Now())
+		// The size chart only gets the sizes read from the node, not a drop to zero.
+		if err == nil {
+			if err := w.AddMempoolSize(mempool.size()); err != nil {
+				return err
+			}
+		}
+		return w.SetMempool(mempool.String())
+	})
+
 	c.Health(ctx, func(health *coretypes.ResultHealth, err error) error {
 		if health != nil && err == nil {
 			return w.SetHealth(statusConnected)
@@ -135,40 +232,258 @@ func Run(ctx context.Context, host string) error {
 	})
 
 	c.Validators(ctx, func(validators coretypes.ResultValidators) error {
+		if err := w.SetVotingPower(formatVotingPower(validators.Validators, monikers)); err != nil {
+			return err
+		}
 		return w.SetValidators(validators.Total)
 	})
 
+	c.StakingValidators(ctx, monikerRefreshInterval, func(validators []stakingtypes.Validator, err error) error {
+		// Keep showing the proposer addresses if the staking module is not reachable.
+		if err != nil {
+			return nil
+		}
+		monikers.set(validators)
+		return nil
+	})
+
+	c.UpgradePlan(ctx, upgradeRefreshInterval, func(plan *upgradetypes.Plan, err error) error {
+		upgrades.setPlan(plan, err)
+		if err := w.SetUpgrade(upgrades.lines()); err != nil {
+			return err
+		}
+		return banner.update()
+	})
+
+	c.Governance(ctx, govRefreshInterval, func(governance client.Governance, err error) error {
+		now := time.Now()
+		for _, id := range gov.set(governance, err, now) {
+			// Proposals that failed to be resolved are left out of the notifications.
+			proposal, err := c.GovProposal(ctx, id)
+			if err == nil {
+				gov.finish(proposal, now)
+			}
+		}
+		if err := w.SetGovernance(gov.String()); err != nil {
+			return err
+		}
+		if err := w.SetGovernanceEvents(gov.lines()); err != nil {
+			return err
+		}
+		return banner.update()
+	})
+
+	c.Economics(ctx, economicsRefreshInterval, func(ec client.Economics, err error) error {
+		economics.set(ec, err)
+		return w.SetEconomics(economics.String())
+	})
+
+	sortUptime := func() error {
+		uptime.nextSort()
+		return w.SetUptime(uptime.String())
+	}
+	w.OnKey('u', sortUptime)
+	w.OnKey('U', sortUptime)
+
+	exportValidatorSet := func() error {
+		valSet.setExported(valSet.export(time.Now()))
+		return w.SetValidatorSet(valSet.String())
+	}
+	w.OnKey('e', exportValidatorSet)
+	w.OnKey('E', exportValidatorSet)
+
+	sortPeers := func() error {
+		peers.nextSort()
+		return w.SetPeerTable(peers.String())
+	}
+	w.OnKey('p', sortPeers)
+	w.OnKey('P', sortPeers)
+
+	// The votes are identified by index in the validator set of the round height,
+	// which may differ from the latest validator set.
+	setConsensusValidators := func() {
+		height, ok := consensus.missingValidators()
+		if !ok {
+			return
+		}
+		validators, err := c.ValidatorsAt(ctx, height)
+		if err == nil {
+			consensus.setValidators(height, validators.Validators)
+		}
+	}
+
+	c.ConsensusState(ctx, func(state client.RoundState, err error) error {
+		consensus.setRoundState(state, err)
+		setConsensusValidators()
+		return updateConsensus(w, consensus)
+	})
+
 	err = c.NewRoundStep(ctx, func(state types.EventDataRoundState) error {
-		progress := 0
-		switch strings.ToUpper(state.Step) {
-		case RoundStepPropose:
-			progress = 20
-		case RoundStepPreVote:
-			progress = 40
-		case RoundStepPreCommit:
-			progress = 60
-		case RoundStepCommit:
-			progress = 80
-		case RoundStepNewHeight:
-			progress = 100
-		}
-		return w.SetBlockProgress(progress)
+		consensus.setRoundStep(state)
+		setConsensusValidators()
+		rounds.setRoundStep(state)
+		halt.setRoundStep(state)
+		if err := updateRounds(w, rounds); err != nil {
+			return err
+		}
+		return updateConsensus(w, consensus)
 	})
 	if err != nil {
 		return err
 	}
 
+	err = c.Timeout(ctx, func(event string, state types.EventDataRoundState) error {
+		rounds.addTimeout(event, state)
+		return updateRounds(w, rounds)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.Vote(ctx, func(vote types.EventDataVote) error {
+		consensus.addVote(vote.Vote)
+		return updateConsensus(w, consensus)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The touched balances are queried at the block height, older results being
+	// dropped by the watch list.
+	watchWorker := newWorker(ctx, watchQueueSize, func(query watchQuery) error {
+		now := time.Now()
+		for address, sources := range query.touched {
+			balances, err := c.Balances(ctx, address, query.height)
+			watchList.update(address, query.height, sources, balances, err, now)
+		}
+		if err := w.SetWatchList(watchList.String()); err != nil {
+			return err
+		}
+		return w.SetBalanceChanges(watchList.changes())
+	})
+
+	// The last commit signatures are ordered by the validator set of the commit height,
+	// which is only fetched again when its hash changes.
+	var (
+		prevHeight         int64
+		prevValidatorsHash []byte
+		validatorsHash     []byte
+		validators         []*types.Validator
+	)
+	uptimeWorker := newWorker(ctx, uptimeQueueSize, func(job uptimeCommit) error {
+		if job.validatorsHash == nil || !bytes.Equal(job.validatorsHash, validatorsHash) {
+			result, err := c.ValidatorsAt(ctx, job.commit.Height)
+			if err != nil {
+				return nil
+			}
+			validatorsHash, validators = job.validatorsHash, result.Validators
+		}
+		uptime.add(job.commit, validators)
+		return w.SetUptime(uptime.String())
+	})
+
+	// Fetch the validator set of every height, the skipped ones included, to diff
+	// the consecutive sets. Only the latest pending height is kept since the
+	// skipped heights are fetched from the last set anyway.
+	valSetWorker := newWorker(ctx, 1, func(height int64) error {
+		for _, h := range valSet.heights(height) {
+			validators, err := c.ValidatorsAt(ctx, h)
+			if err != nil {
+				break
+			}
+			valSet.set(h, validators.Validators)
+		}
+		return w.SetValidatorSet(valSet.String())
+	})
+
 	err = c.NewBlock(ctx, func(block types.EventDataNewBlock) error {
+		var blockGasUsed int64
+		for _, result := range block.ResultFinalizeBlock.TxResults {
+			blockGasUsed += result.GasUsed
+		}
+
 		info.Lock()
 		info.blocks++
+		info.lastBlockGasUsed = blockGasUsed
 		info.Unlock()
 
+		// The validator set hash of the commit height is the one of the previous block.
+		if commit := block.Block.LastCommit; commit != nil && commit.Height > 0 {
+			var validatorsHash []byte
+			if prevHeight == commit.Height {
+				validatorsHash = prevValidatorsHash
+			}
+			uptimeWorker.send(uptimeCommit{commit, validatorsHash})
+		}
+		prevHeight, prevValidatorsHash = block.Block.Height, block.Block.ValidatorsHash
+
+		valSetWorker.send(block.Block.Height)
+
+		intervals.add(block.Block.Height, block.Block.Header.Time)
+		if err := w.SetBlockIntervals(intervals.String()); err != nil {
+			return err
+		}
+
+		tps.add(block.Block.Height, block.Block.Header.Time, block.Block.Txs.Len())
+		if err := w.SetThroughput(tps.String()); err != nil {
+			return err
+		}
+
+		activity.add(block)
+		if err := w.SetActivity(activity.String()); err != nil {
+			return err
+		}
+
+		accounts.add(block)
+		if err := w.SetAccounts(accounts.String()); err != nil {
+			return err
+		}
+
+		fees.add(block)
+		if err := w.SetFees(fees.String()); err != nil {
+			return err
+		}
+
+		if evidences.add(block.Block) > 0 {
+			if err := w.SetEvidence(evidences.lines()); err != nil {
+				return err
+			}
+		}
+
+		if touched := watchList.touched(block); len(touched) > 0 {
+			watchWorker.send(watchQuery{block.Block.Height, touched})
+		}
+
+		upgrades.addBlock(block.Block.Height, block.Block.Header.Time)
+		if err := w.SetUpgrade(upgrades.lines()); err != nil {
+			return err
+		}
+
+		halt.addBlock(block.Block.Height, time.Now())
+		if err := banner.update(); err != nil {
+			return err
+		}
+
+		size := int64(block.Block.Size())
+		sizes.add(size)
+		if err := w.SetMaxBlockSize(sizes.String()); err != nil {
+			return err
+		}
+
+		proposer := block.Block.Header.ProposerAddress.String()
+		proposers.add(proposer)
+		if err := w.SetProposers(proposers.String()); err != nil {
+			return err
+		}
+
 		return w.AddBlock(
 			fmt.Sprintf(
-				"%d %s txs:%d",
+				"%d %s txs:%d size:%s proposer:%s",
 				block.Block.Height,
 				block.Block.Header.Hash(),
 				block.Block.Txs.Len(),
+				number.ByteCountDecimal(size),
+				monikers.name(proposer),
 			),
 		)
 	})
@@ -180,7 +495,9 @@ func Run(ctx context.Context, host string) error {
 		info.Lock()
 		info.transactions++
 		info.lastTxGasWanted = tx.Result.GasWanted
+		info.lastTxGasUsed = tx.Result.GasUsed
 		info.totalGasWanted += info.lastTxGasWanted
+		info.totalGasUsed += info.lastTxGasUsed
 		info.Unlock()
 
 		result, err := json.Marshal(tx.Result)
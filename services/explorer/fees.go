@@ -0,0 +1,156 @@
+package explorer
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gex/pkg/cosmostx"
+	"github.com/ignite/gex/pkg/number"
+)
+
+// gasPriceStats holds the gas price statistics of a denom in a block.
+type gasPriceStats struct {
+	txs    int
+	min    float64
+	median float64
+	p90    float64
+}
+
+// fees tracks the transaction fees by denom and the observed gas prices.
+type fees struct {
+	sync.RWMutex
+	txs         int
+	totals      sdk.Coins
+	height      int64
+	blockTxs    int
+	blockPrices map[string]gasPriceStats
+	minPrices   map[string]float64
+}
+
+// newFees creates a new fees tracker.
+func newFees() *fees {
+	return &fees{
+		blockPrices: make(map[string]gasPriceStats),
+		minPrices:   make(map[string]float64),
+	}
+}
+
+// gasPrice returns the coin amount paid per unit of gas.
+func gasPrice(coin sdk.Coin, gas uint64) float64 {
+	amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+	return amount / float64(gas)
+}
+
+// addCoins returns the sum of the coins, or false if an amount overflows the
+// 256 bits of the sdk integers, since the coins addition panics in this case.
+func addCoins(a sdk.Coins, b ...sdk.Coin) (sum sdk.Coins, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			sum, ok = nil, false
+		}
+	}()
+	return a.Add(b...), true
+}
+
+// add adds the fees of a new block and computes the block gas prices.
+// The gas price of each fee denom is the fee amount divided by the tx gas limit.
+// The txs whose fee overflows the totals are skipped.
+func (f *fees) add(block types.EventDataNewBlock) {
+	var (
+		txs    int
+		totals sdk.Coins
+		prices = make(map[string][]float64)
+	)
+	for _, bz := range block.Block.Txs {
+		tx, err := cosmostx.Decode(bz)
+		if err != nil {
+			continue
+		}
+		sum, ok := addCoins(totals, tx.Fee...)
+		if !ok {
+			continue
+		}
+		txs++
+		totals = sum
+		if tx.GasLimit == 0 {
+			continue
+		}
+		for _, coin := range tx.Fee {
+			prices[coin.Denom] = append(prices[coin.Denom], gasPrice(coin, tx.GasLimit))
+		}
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	sum, ok := addCoins(f.totals, totals...)
+	if !ok {
+		return
+	}
+	f.txs += txs
+	f.totals = sum
+	if txs == 0 {
+		return
+	}
+
+	f.height = block.Block.Height
+	f.blockTxs = txs
+	f.blockPrices = make(map[string]gasPriceStats, len(prices))
+	for denom, values := range prices {
+		stats := gasPriceStats{
+			txs:    len(values),
+			min:    number.Percentile(values, 0),
+			median: number.Percentile(values, 50),
+			p90:    number.Percentile(values, 90),
+		}
+		f.blockPrices[denom] = stats
+		if minPrice, ok := f.minPrices[denom]; !ok || stats.min < minPrice {
+			f.minPrices[denom] = stats.min
+		}
+	}
+}
+
+// String returns the fees totals and the gas price statistics.
+func (f *fees) String() string {
+	f.RLock()
+	defer f.RUnlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "total fees (%d txs):\n", f.txs)
+	for _, coin := range f.totals {
+		fmt.Fprintf(&b, "  %s %s\n", coin.Amount, coin.Denom)
+	}
+
+	if f.height == 0 {
+		return b.String()
+	}
+
+	denoms := make([]string, 0, len(f.blockPrices))
+	for denom := range f.blockPrices {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	fmt.Fprintf(&b, "\ngas prices at height %d (%d txs)\n", f.height, f.blockTxs)
+	fmt.Fprintf(&b, "%-20s %5s %12s %12s %12s %12s\n", "DENOM", "TXS", "MIN", "MEDIAN", "P90", "SESSION MIN")
+	for _, denom := range denoms {
+		stats := f.blockPrices[denom]
+		fmt.Fprintf(
+			&b,
+			"%-20.20s %5d %12.6g %12.6g %12.6g %12.6g\n",
+			denom,
+			stats.txs,
+			stats.min,
+			stats.median,
+			stats.p90,
+			f.minPrices[denom],
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package explorer
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// maxAmount is the largest sdk integer amount, one more overflows.
+var maxAmount = math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)))
+
+func TestAddCoins(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      sdk.Coins
+		b      sdk.Coins
+		want   sdk.Coins
+		wantOK bool
+	}{
+		{
+			name:   "empty",
+			want:   sdk.Coins{},
+			wantOK: true,
+		},
+		{
+			name:   "same denom",
+			a:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			b:      sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			want:   sdk.NewCoins(sdk.NewInt64Coin("stake", 15)),
+			wantOK: true,
+		},
+		{
+			name:   "new denom",
+			a:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			b:      sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			want:   sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)),
+			wantOK: true,
+		},
+		{
+			name: "overflow",
+			a:    sdk.NewCoins(sdk.NewCoin("stake", maxAmount)),
+			b:    sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := addCoins(tt.a, tt.b...)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package explorer
+
+import (
+	"fmt"
+
+	"github.com/ignite/gex/pkg/number"
+)
+
+// formatGasUsage formats the gas wanted and gas used amounts.
+func formatGasUsage(wanted, used int64) string {
+	return fmt.Sprintf("wanted: %s\nused: %s", number.WithComma(wanted), number.WithComma(used))
+}
+
+// formatGasEfficiency formats the gas wanted and gas used amounts with the used/wanted ratio.
+func formatGasEfficiency(wanted, used int64) string {
+	return fmt.Sprintf("%s\nratio: %s", formatGasUsage(wanted, used), number.Percentage(used, wanted))
+}
+
+// formatBlockGasUtilization formats the block max gas and the last block utilization.
+// A max gas lower or equal to zero means the block gas is unlimited.
+func formatBlockGasUtilization(maxGas, blockGasUsed int64) string {
+	if maxGas <= 0 {
+		return fmt.Sprintf("unlimited\nlast block: %s", number.WithComma(blockGasUsed))
+	}
+	return fmt.Sprintf(
+		"%s\nlast block: %s",
+		number.WithComma(maxGas),
+		number.Percentage(blockGasUsed, maxGas),
+	)
+}
@@ -0,0 +1,267 @@
+package explorer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/ignite/gex/pkg/client"
+	"github.com/ignite/gex/pkg/widget"
+)
+
+const (
+	// govRefreshInterval is the number of blocks between governance queries.
+	govRefreshInterval = 10
+
+	// govBannerDuration is the duration the latest proposal status change is shown in the banner.
+	govBannerDuration = 5 * time.Minute
+)
+
+// govEvent holds a proposal status change noticed during the session.
+type govEvent struct {
+	at      time.Time
+	id      uint64
+	title   string
+	message string
+}
+
+// governance tracks the active proposals and notifies their status changes.
+type governance struct {
+	sync.RWMutex
+	gov      client.Governance
+	err      error
+	statuses map[uint64]govv1.ProposalStatus
+	events   []govEvent
+}
+
+// newGovernance creates a new governance tracker.
+func newGovernance() *governance {
+	return &governance{}
+}
+
+// set sets the active proposals and returns the ids of the proposals that left the voting
+// period, whose final status must be resolved with finish.
+func (g *governance) set(gov client.Governance, err error, now time.Time) []uint64 {
+	g.Lock()
+	defer g.Unlock()
+
+	g.err = err
+	if err != nil {
+		return nil
+	}
+	g.gov = gov
+
+	statuses := make(map[uint64]govv1.ProposalStatus, len(gov.Proposals))
+	for _, proposal := range gov.Proposals {
+		statuses[proposal.Id] = proposal.Status
+		// The first query only sets the known statuses.
+		if g.statuses == nil || proposal.Status != govv1.StatusVotingPeriod {
+			continue
+		}
+		if g.statuses[proposal.Id] != govv1.StatusVotingPeriod {
+			g.events = append(g.events, govEvent{
+				at:      now,
+				id:      proposal.Id,
+				title:   proposal.Title,
+				message: "entered the voting period",
+			})
+		}
+	}
+
+	var ended []uint64
+	for id, status := range g.statuses {
+		if _, ok := statuses[id]; !ok && status == govv1.StatusVotingPeriod {
+			ended = append(ended, id)
+		}
+	}
+	g.statuses = statuses
+	return ended
+}
+
+// finish adds the final status of a proposal that left the voting period.
+func (g *governance) finish(proposal govv1.Proposal, now time.Time) {
+	g.Lock()
+	defer g.Unlock()
+
+	message := formatProposalStatus(proposal.Status)
+	if proposal.FailedReason != "" {
+		message = fmt.Sprintf("%s: %s", message, proposal.FailedReason)
+	}
+	g.events = append(g.events, govEvent{
+		at:      now,
+		id:      proposal.Id,
+		title:   proposal.Title,
+		message: message,
+	})
+}
+
+// formatProposalStatus formats the proposal status without the enum prefix.
+func formatProposalStatus(status govv1.ProposalStatus) string {
+	name := strings.TrimPrefix(status.String(), "PROPOSAL_STATUS_")
+	return strings.ToLower(strings.ReplaceAll(name, "_", " "))
+}
+
+// toFloat converts an integer amount string to a float, zero if invalid.
+func toFloat(amount string) float64 {
+	i, ok := math.NewIntFromString(amount)
+	if !ok {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(i.BigInt()).Float64()
+	return f
+}
+
+// ratio returns the part of the total as a percentage, zero if the total is zero.
+func ratio(part, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return part / total * 100
+}
+
+// formatTimeLeft formats the time with the duration left until it.
+func formatTimeLeft(t *time.Time, now time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	left := t.Sub(now).Truncate(time.Second)
+	if left < 0 {
+		left = 0
+	}
+	return fmt.Sprintf("%s (in %s)", t.Format(time.DateTime), left)
+}
+
+// String returns the active proposals with their tally or deposit progress.
+func (g *governance) String() string {
+	g.RLock()
+	defer g.RUnlock()
+
+	if g.err != nil {
+		return fmt.Sprintf("gov module not reachable: %s", g.err)
+	}
+	if len(g.gov.Proposals) == 0 {
+		return "no proposal in deposit or voting period"
+	}
+
+	var (
+		now    = time.Now()
+		params = g.gov.Params
+		bonded = toFloat(g.gov.BondedTokens.String())
+		b      strings.Builder
+	)
+	fmt.Fprintf(
+		&b,
+		"quorum: %s, threshold: %s, veto threshold: %s\n",
+		formatDecPercentage(params.Quorum),
+		formatDecPercentage(params.Threshold),
+		formatDecPercentage(params.VetoThreshold),
+	)
+	for _, proposal := range g.gov.Proposals {
+		kind := ""
+		if proposal.Expedited {
+			kind = " expedited"
+		}
+		fmt.Fprintf(
+			&b,
+			"\n#%d [%s%s] %s\n",
+			proposal.Id,
+			formatProposalStatus(proposal.Status),
+			kind,
+			proposal.Title,
+		)
+
+		if proposal.Status != govv1.StatusVotingPeriod {
+			minDeposit := sdk.NewCoins(params.MinDeposit...)
+			if proposal.Expedited {
+				minDeposit = sdk.NewCoins(params.ExpeditedMinDeposit...)
+			}
+			fmt.Fprintf(&b, "  deposit ends: %s\n", formatTimeLeft(proposal.DepositEndTime, now))
+			fmt.Fprintf(&b, "  deposit: %s / %s\n", sdk.NewCoins(proposal.TotalDeposit...), minDeposit)
+			continue
+		}
+
+		var (
+			tally   = proposal.Tally
+			yes     = toFloat(tally.YesCount)
+			no      = toFloat(tally.NoCount)
+			veto    = toFloat(tally.NoWithVetoCount)
+			abstain = toFloat(tally.AbstainCount)
+			total   = yes + no + veto + abstain
+		)
+		fmt.Fprintf(&b, "  voting ends: %s\n", formatTimeLeft(proposal.VotingEndTime, now))
+		fmt.Fprintf(
+			&b,
+			"  yes: %.2f%%  no: %.2f%%  veto: %.2f%%  abstain: %.2f%%\n",
+			ratio(yes, total),
+			ratio(no, total),
+			ratio(veto, total),
+			ratio(abstain, total),
+		)
+		fmt.Fprintf(
+			&b,
+			"  turnout: %.2f%% of the bonded tokens (quorum %s)\n",
+			ratio(total, bonded),
+			formatDecPercentage(params.Quorum),
+		)
+	}
+	return b.String()
+}
+
+// formatDecPercentage formats a decimal ratio string as a percentage.
+func formatDecPercentage(dec string) string {
+	d, err := math.LegacyNewDecFromStr(dec)
+	if err != nil {
+		return dec
+	}
+	f, err := d.Float64()
+	if err != nil {
+		return dec
+	}
+	return fmt.Sprintf("%.2f%%", f*100)
+}
+
+// banner returns the latest proposal status change if it happened recently, empty otherwise.
+func (g *governance) banner(now time.Time) string {
+	g.RLock()
+	defer g.RUnlock()
+
+	if len(g.events) == 0 {
+		return ""
+	}
+	event := g.events[len(g.events)-1]
+	if now.Sub(event.at) > govBannerDuration {
+		return ""
+	}
+	return fmt.Sprintf("⚠ PROPOSAL #%d %s: %s", event.id, event.title, strings.ToUpper(event.message))
+}
+
+// lines returns the proposal status changes from the newest as alert lines.
+func (g *governance) lines() []widget.Line {
+	g.RLock()
+	defer g.RUnlock()
+
+	if len(g.events) == 0 {
+		return []widget.Line{{Text: "no proposal status change during the session"}}
+	}
+	lines := make([]widget.Line, 0, len(g.events))
+	for i := len(g.events) - 1; i >= 0; i-- {
+		event := g.events[i]
+		lines = append(lines, widget.Line{
+			Text: fmt.Sprintf(
+				"%s #%d %s: %s",
+				event.at.Format(time.DateTime),
+				event.id,
+				event.title,
+				event.message,
+			),
+			Alert: true,
+		})
+	}
+	return lines
+}
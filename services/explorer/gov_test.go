@@ -0,0 +1,136 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/gex/pkg/client"
+)
+
+func TestGovernanceEvents(t *testing.T) {
+	const (
+		deposit = govv1.StatusDepositPeriod
+		voting  = govv1.StatusVotingPeriod
+	)
+	type query struct {
+		statuses map[uint64]govv1.ProposalStatus
+		err      error
+	}
+	tests := []struct {
+		name      string
+		queries   []query
+		finals    map[uint64]govv1.Proposal
+		wantEnded []uint64
+		want      []string
+	}{
+		{
+			name:    "first query only sets the statuses",
+			queries: []query{{statuses: map[uint64]govv1.ProposalStatus{1: voting, 2: deposit}}},
+			want:    []string{},
+		},
+		{
+			name: "new proposal in voting period",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{}},
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+			},
+			want: []string{"#1 entered the voting period"},
+		},
+		{
+			name: "deposit to voting period",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: deposit}},
+				{statuses: map[uint64]govv1.ProposalStatus{1: deposit}},
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+			},
+			want: []string{"#1 entered the voting period"},
+		},
+		{
+			name: "voting to passed",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+				{statuses: map[uint64]govv1.ProposalStatus{}},
+			},
+			finals:    map[uint64]govv1.Proposal{1: {Id: 1, Status: govv1.StatusPassed}},
+			wantEnded: []uint64{1},
+			want:      []string{"#1 passed"},
+		},
+		{
+			name: "voting to rejected",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting, 2: voting}},
+				{statuses: map[uint64]govv1.ProposalStatus{2: voting}},
+			},
+			finals:    map[uint64]govv1.Proposal{1: {Id: 1, Status: govv1.StatusRejected}},
+			wantEnded: []uint64{1},
+			want:      []string{"#1 rejected"},
+		},
+		{
+			name: "voting to failed with the reason",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+				{statuses: map[uint64]govv1.ProposalStatus{}},
+			},
+			finals: map[uint64]govv1.Proposal{
+				1: {Id: 1, Status: govv1.StatusFailed, FailedReason: "out of gas"},
+			},
+			wantEnded: []uint64{1},
+			want:      []string{"#1 failed: out of gas"},
+		},
+		{
+			name: "removed in deposit period",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: deposit}},
+				{statuses: map[uint64]govv1.ProposalStatus{}},
+			},
+			want: []string{},
+		},
+		{
+			name: "failed query keeps the statuses",
+			queries: []query{
+				{statuses: map[uint64]govv1.ProposalStatus{1: voting}},
+				{err: errors.New("unreachable")},
+				{statuses: map[uint64]govv1.ProposalStatus{}},
+			},
+			finals:    map[uint64]govv1.Proposal{1: {Id: 1, Status: govv1.StatusPassed}},
+			wantEnded: []uint64{1},
+			want:      []string{"#1 passed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				g     = newGovernance()
+				now   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				ended []uint64
+			)
+			for _, q := range tt.queries {
+				var gov client.Governance
+				for id, status := range q.statuses {
+					gov.Proposals = append(gov.Proposals, client.Proposal{
+						Proposal: govv1.Proposal{Id: id, Status: status},
+					})
+				}
+				for _, id := range g.set(gov, q.err, now) {
+					ended = append(ended, id)
+					g.finish(tt.finals[id], now)
+				}
+			}
+			sort.Slice(ended, func(i, j int) bool { return ended[i] < ended[j] })
+
+			got := make([]string, 0, len(g.events))
+			for _, event := range g.events {
+				got = append(got, fmt.Sprintf("#%d %s", event.id, event.message))
+			}
+			require.Equal(t, tt.wantEnded, ended)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
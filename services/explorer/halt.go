@@ -0,0 +1,132 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// haltWindow is the number of latest block intervals used to compute the block time.
+	haltWindow = 100
+
+	// haltBlockTimes is the number of block times without a new block before the chain is considered halted.
+	haltBlockTimes = 5
+
+	// haltMinDuration is the minimum time without a new block before the chain is considered halted.
+	haltMinDuration = 10 * time.Second
+
+	// haltDefaultDuration is the time without a new block before the chain is considered
+	// halted while the block time is unknown.
+	haltDefaultDuration = time.Minute
+)
+
+// halt detects when the chain stops producing blocks.
+// The block time is measured from the blocks arrival, so the halt is
+// detected even if the block header times are wrong. Only the first
+// reference is the latest block header time from the node status, so
+// a chain already halted is detected at startup.
+type halt struct {
+	sync.RWMutex
+	lastHeight int64
+	lastBlock  time.Time
+	intervals  *window.Window[time.Duration]
+	step       types.EventDataRoundState
+	halted     bool
+}
+
+// newHalt creates a new chain halt detector.
+func newHalt(size int) *halt {
+	return &halt{intervals: window.New[time.Duration](size)}
+}
+
+// seed sets the latest block height and header time from the node status
+// as the reference until a new block arrives.
+func (h *halt) seed(height int64, blockTime time.Time) {
+	h.Lock()
+	defer h.Unlock()
+	if h.lastHeight > 0 {
+		return
+	}
+	h.lastHeight = height
+	h.lastBlock = blockTime
+}
+
+// addBlock adds the height and arrival time of a new block, clearing the halt.
+// The interval is only recorded from the previous height.
+func (h *halt) addBlock(height int64, now time.Time) {
+	h.Lock()
+	defer h.Unlock()
+	if height <= h.lastHeight {
+		return
+	}
+	if h.lastHeight > 0 && height == h.lastHeight+1 {
+		h.intervals.Push(now.Sub(h.lastBlock))
+	}
+	h.lastHeight = height
+	h.lastBlock = now
+	h.halted = false
+}
+
+// setRoundStep sets the last consensus round step reached.
+func (h *halt) setRoundStep(state types.EventDataRoundState) {
+	h.Lock()
+	defer h.Unlock()
+	h.step = state
+}
+
+// threshold returns the time without a new block before the chain is considered halted.
+// Caller must hold the lock.
+func (h *halt) threshold() time.Duration {
+	intervals := h.intervals.Values()
+	if len(intervals) == 0 {
+		return haltDefaultDuration
+	}
+	var total time.Duration
+	for _, interval := range intervals {
+		total += interval
+	}
+	threshold := total / time.Duration(len(intervals)) * haltBlockTimes
+	if threshold < haltMinDuration {
+		threshold = haltMinDuration
+	}
+	return threshold
+}
+
+// check checks if the chain is halted and returns true if the halt was just detected.
+func (h *halt) check(now time.Time) bool {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.halted || h.lastBlock.IsZero() || now.Sub(h.lastBlock) < h.threshold() {
+		return false
+	}
+	h.halted = true
+	return true
+}
+
+// banner returns the halt banner while the chain is halted, otherwise an empty string.
+func (h *halt) banner(now time.Time) string {
+	h.RLock()
+	defer h.RUnlock()
+
+	if !h.halted {
+		return ""
+	}
+	banner := fmt.Sprintf("⚠ CHAIN HALTED: NO BLOCK FOR %s", now.Sub(h.lastBlock).Truncate(time.Second))
+	if h.step.Height > 0 {
+		banner = fmt.Sprintf(
+			"%s, LAST STEP %s AT HEIGHT %d ROUND %d",
+			banner,
+			strings.ToUpper(strings.TrimPrefix(h.step.Step, "RoundStep")),
+			h.step.Height,
+			h.step.Round,
+		)
+	}
+	return banner
+}
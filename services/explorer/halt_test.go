@@ -0,0 +1,113 @@
+package explorer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHalt(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type block struct {
+		height int64
+		at     time.Duration
+	}
+	tests := []struct {
+		name       string
+		seedHeight int64
+		blocks     []block
+		at         time.Duration
+		want       bool
+	}{
+		{
+			name: "no reference",
+			at:   time.Hour,
+		},
+		{
+			name:       "startup before the default duration",
+			seedHeight: 10,
+			at:         haltDefaultDuration - time.Second,
+		},
+		{
+			name:       "halted at startup",
+			seedHeight: 10,
+			at:         haltDefaultDuration,
+			want:       true,
+		},
+		{
+			name:   "single block without the block time",
+			blocks: []block{{10, 0}},
+			at:     haltDefaultDuration,
+			want:   true,
+		},
+		{
+			name:       "block time from the seeded block",
+			seedHeight: 10,
+			blocks:     []block{{11, 5 * time.Second}, {12, 10 * time.Second}},
+			at:         10*time.Second + 5*5*time.Second,
+			want:       true,
+		},
+		{
+			name:       "before the block times threshold",
+			seedHeight: 10,
+			blocks:     []block{{11, 5 * time.Second}, {12, 10 * time.Second}},
+			at:         10*time.Second + 5*5*time.Second - time.Second,
+		},
+		{
+			name:   "min duration with fast blocks",
+			blocks: []block{{10, 0}, {11, time.Second}, {12, 2 * time.Second}},
+			at:     2*time.Second + haltMinDuration - time.Second,
+		},
+		{
+			name:   "missed heights are not block times",
+			blocks: []block{{10, 0}, {11, time.Second}, {20, 2 * time.Minute}},
+			at:     2*time.Minute + haltMinDuration,
+			want:   true,
+		},
+		{
+			name:       "older heights do not clear the halt",
+			seedHeight: 10,
+			blocks:     []block{{9, 30 * time.Second}},
+			at:         haltDefaultDuration,
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHalt(10)
+			if tt.seedHeight > 0 {
+				h.seed(tt.seedHeight, start)
+			}
+			for _, b := range tt.blocks {
+				h.addBlock(b.height, start.Add(b.at))
+			}
+			require.Equal(t, tt.want, h.check(start.Add(tt.at)))
+		})
+	}
+}
+
+func TestHaltClear(t *testing.T) {
+	var (
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		h     = newHalt(10)
+	)
+	h.seed(10, start)
+	h.setRoundStep(types.EventDataRoundState{Height: 11, Round: 2, Step: "RoundStepPrevote"})
+
+	// The halt is only reported once while the chain is halted.
+	now := start.Add(2 * time.Minute)
+	require.True(t, h.check(now))
+	require.False(t, h.check(now.Add(time.Second)))
+	require.Equal(t, "⚠ CHAIN HALTED: NO BLOCK FOR 2m1s, LAST STEP PREVOTE AT HEIGHT 11 ROUND 2", h.banner(now.Add(time.Second)))
+
+	// A new block clears the halt, which is reported again on the next halt,
+	// the block time being the interval since the seeded block.
+	interval := 2*time.Minute + 2*time.Second
+	h.addBlock(11, start.Add(interval))
+	require.Empty(t, h.banner(start.Add(interval)))
+	require.False(t, h.check(start.Add(interval+time.Second)))
+	require.True(t, h.check(start.Add(interval+interval*haltBlockTimes)))
+}
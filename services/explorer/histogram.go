@@ -0,0 +1,63 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// histogramBarWidth is the max width of a histogram bar in cells.
+const histogramBarWidth = 30
+
+// histogramBucket holds a labeled count of a histogram.
+type histogramBucket struct {
+	label string
+	count int
+}
+
+// sortedBuckets returns the counts as buckets sorted by descending count and label.
+func sortedBuckets(counts map[string]int) []histogramBucket {
+	buckets := make([]histogramBucket, 0, len(counts))
+	for label, count := range counts {
+		buckets = append(buckets, histogramBucket{label: label, count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].count == buckets[j].count {
+			return buckets[i].label < buckets[j].label
+		}
+		return buckets[i].count > buckets[j].count
+	})
+	return buckets
+}
+
+// formatHistogram formats the buckets as a text histogram with bars scaled to the largest count.
+func formatHistogram(buckets []histogramBucket, labelWidth int) string {
+	largest := 0
+	for _, bucket := range buckets {
+		if bucket.count > largest {
+			largest = bucket.count
+		}
+	}
+
+	var b strings.Builder
+	for _, bucket := range buckets {
+		width := 0
+		if largest > 0 {
+			width = bucket.count * histogramBarWidth / largest
+		}
+		if width == 0 && bucket.count > 0 {
+			width = 1
+		}
+		fmt.Fprintf(
+			&b,
+			"%-*.*s %-*s %d\n",
+			labelWidth,
+			labelWidth,
+			bucket.label,
+			histogramBarWidth,
+			strings.Repeat("█", width),
+			bucket.count,
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package explorer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedBuckets(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts map[string]int
+		want   []histogramBucket
+	}{
+		{"empty", nil, []histogramBucket{}},
+		{
+			name:   "descending count",
+			counts: map[string]int{"bank": 1, "staking": 5, "gov": 3},
+			want:   []histogramBucket{{"staking", 5}, {"gov", 3}, {"bank", 1}},
+		},
+		{
+			name:   "ties sorted by label",
+			counts: map[string]int{"gov": 2, "bank": 2, "auth": 0},
+			want:   []histogramBucket{{"bank", 2}, {"gov", 2}, {"auth", 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, sortedBuckets(tt.counts))
+		})
+	}
+}
+
+func TestFormatHistogram(t *testing.T) {
+	bar := func(width int) string {
+		return strings.Repeat("█", width) + strings.Repeat(" ", histogramBarWidth-width)
+	}
+	tests := []struct {
+		name       string
+		buckets    []histogramBucket
+		labelWidth int
+		want       string
+	}{
+		{"no buckets", nil, 4, ""},
+		{
+			name:       "bars scaled to the largest count",
+			buckets:    []histogramBucket{{"a", 10}, {"b", 5}},
+			labelWidth: 2,
+			want:       "a  " + bar(30) + " 10\nb  " + bar(15) + " 5\n",
+		},
+		{
+			name:       "small count shows a minimal bar",
+			buckets:    []histogramBucket{{"a", 1000}, {"b", 1}},
+			labelWidth: 1,
+			want:       "a " + bar(30) + " 1000\nb " + bar(1) + " 1\n",
+		},
+		{
+			name:       "zero counts show no bar",
+			buckets:    []histogramBucket{{"a", 0}, {"b", 0}},
+			labelWidth: 1,
+			want:       "a " + bar(0) + " 0\nb " + bar(0) + " 0\n",
+		},
+		{
+			name:       "long labels are truncated",
+			buckets:    []histogramBucket{{"staking", 1}},
+			labelWidth: 4,
+			want:       "stak " + bar(30) + " 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, formatHistogram(tt.buckets, tt.labelWidth))
+		})
+	}
+}
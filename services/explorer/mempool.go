@@ -0,0 +1,119 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/cosmostx"
+	"github.com/ignite/gex/pkg/number"
+)
+
+// mempool tracks the unconfirmed transactions of the node mempool.
+// The mempool does not expose when a transaction arrived, so the
+// transaction age is measured since gex saw it for the first time.
+type mempool struct {
+	sync.RWMutex
+	total      int
+	totalBytes int64
+	txs        []types.Tx
+	firstSeen  map[string]time.Time
+	err        error
+}
+
+// newMempool creates a new mempool tracker.
+func newMempool() *mempool {
+	return &mempool{firstSeen: make(map[string]time.Time)}
+}
+
+// set sets the unconfirmed transactions, keeping the first time each transaction was seen.
+// The transactions are kept on error, so their age is not reset once the node is reachable.
+func (m *mempool) set(unconfirmed coretypes.ResultUnconfirmedTxs, err error, now time.Time) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.err = err
+	if err != nil {
+		return
+	}
+
+	firstSeen := make(map[string]time.Time, len(unconfirmed.Txs))
+	for _, tx := range unconfirmed.Txs {
+		hash := fmt.Sprintf("%X", tx.Hash())
+		seen, ok := m.firstSeen[hash]
+		if !ok {
+			seen = now
+		}
+		firstSeen[hash] = seen
+	}
+
+	m.total = unconfirmed.Total
+	m.totalBytes = unconfirmed.TotalBytes
+	m.txs = unconfirmed.Txs
+	m.firstSeen = firstSeen
+}
+
+// size returns the number of unconfirmed transactions and their size in bytes.
+func (m *mempool) size() (int, int64) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.total, m.totalBytes
+}
+
+// String returns the mempool summary and the pending transactions, from the oldest.
+func (m *mempool) String() string {
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.err != nil {
+		return fmt.Sprintf("mempool not reachable: %s\n", m.err)
+	}
+
+	var (
+		now    = time.Now()
+		oldest = "-"
+		b      strings.Builder
+	)
+	if len(m.txs) > 0 {
+		seen := m.firstSeen[fmt.Sprintf("%X", m.txs[0].Hash())]
+		oldest = now.Sub(seen).Truncate(time.Second).String()
+	}
+
+	fmt.Fprintf(&b, "pending txs: %d\n", m.total)
+	fmt.Fprintf(&b, "pending size: %s\n", number.ByteCountDecimal(m.totalBytes))
+	fmt.Fprintf(&b, "oldest tx age: %s\n", oldest)
+	if m.total > len(m.txs) {
+		fmt.Fprintf(&b, "showing the oldest %d txs\n", len(m.txs))
+	}
+
+	for _, bz := range m.txs {
+		hash := fmt.Sprintf("%X", bz.Hash())
+		age := now.Sub(m.firstSeen[hash]).Truncate(time.Second)
+
+		tx, err := cosmostx.Decode(bz)
+		if err != nil {
+			fmt.Fprintf(&b, "\n%s age:%s size:%s (undecodable)\n", hash, age, number.ByteCountDecimal(int64(len(bz))))
+			continue
+		}
+		fmt.Fprintf(
+			&b,
+			"\n%s age:%s size:%s gas:%s fee:%s\n",
+			hash,
+			age,
+			number.ByteCountDecimal(int64(len(bz))),
+			number.WithComma(int64(tx.GasLimit)),
+			tx.Fee,
+		)
+		for _, msg := range tx.Messages {
+			fmt.Fprintf(&b, "  %s\n", msg)
+		}
+		if tx.Memo != "" {
+			fmt.Fprintf(&b, "  memo: %s\n", tx.Memo)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// monikerRefreshInterval is the number of blocks between each validator monikers refresh.
+const monikerRefreshInterval = 100
+
+// monikers holds the validator monikers indexed by the hex consensus address.
+type monikers struct {
+	sync.RWMutex
+	names map[string]string
+}
+
+// newMonikers creates a new validator monikers holder.
+func newMonikers() *monikers {
+	return &monikers{names: make(map[string]string)}
+}
+
+// set sets the validator monikers from the staking module validators.
+// Validators with invalid consensus public keys are ignored.
+func (m *monikers) set(validators []stakingtypes.Validator) {
+	names := make(map[string]string, len(validators))
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			continue
+		}
+		names[fmt.Sprintf("%X", consAddr)] = validator.GetMoniker()
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.names = names
+}
+
+// name returns the validator moniker of the consensus address or the address if it is unknown.
+func (m *monikers) name(address string) string {
+	m.RLock()
+	defer m.RUnlock()
+	if moniker, ok := m.names[address]; ok && moniker != "" {
+		return moniker
+	}
+	return address
+}
@@ -0,0 +1,72 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/ignite/gex/pkg/layout"
+	"github.com/ignite/gex/pkg/theme"
+)
+
+// Option configures the explorer.
+type Option func(*options)
+
+// options holds the explorer configuration.
+type options struct {
+	watchAddresses  []string
+	bell            bool
+	intervalBuckets []time.Duration
+	intervalWindow  int
+	layout          *layout.Layout
+	theme           *theme.Theme
+}
+
+// newOptions returns the explorer configuration with the options applied.
+func newOptions(opts ...Option) options {
+	o := options{intervalWindow: DefaultBlockIntervalWindow}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithWatchAddresses watches the balances of the addresses.
+func WithWatchAddresses(addresses ...string) Option {
+	return func(o *options) {
+		o.watchAddresses = append(o.watchAddresses, addresses...)
+	}
+}
+
+// WithBell rings the terminal bell when the chain halts.
+func WithBell() Option {
+	return func(o *options) {
+		o.bell = true
+	}
+}
+
+// WithBlockIntervalBuckets sets the upper bounds of the block interval histogram buckets.
+func WithBlockIntervalBuckets(buckets ...time.Duration) Option {
+	return func(o *options) {
+		o.intervalBuckets = append(o.intervalBuckets, buckets...)
+	}
+}
+
+// WithBlockIntervalWindow sets the number of latest block intervals of the histogram.
+func WithBlockIntervalWindow(size int) Option {
+	return func(o *options) {
+		o.intervalWindow = size
+	}
+}
+
+// WithLayout sets the dashboard layout instead of the built-in one.
+func WithLayout(l layout.Layout) Option {
+	return func(o *options) {
+		o.layout = &l
+	}
+}
+
+// WithTheme sets the dashboard color theme instead of the default one.
+func WithTheme(t theme.Theme) Option {
+	return func(o *options) {
+		o.theme = &t
+	}
+}
@@ -0,0 +1,132 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/ignite/gex/pkg/number"
+)
+
+// peerSort represents the peer table sort column.
+type peerSort int
+
+const (
+	peerSortMoniker peerSort = iota
+	peerSortDirection
+	peerSortSendRate
+	peerSortRecvRate
+)
+
+// String returns the peer sort column name.
+func (s peerSort) String() string {
+	switch s {
+	case peerSortMoniker:
+		return "moniker"
+	case peerSortDirection:
+		return "direction"
+	case peerSortSendRate:
+		return "send rate"
+	case peerSortRecvRate:
+		return "receive rate"
+	default:
+		return "unknown"
+	}
+}
+
+// peers holds the node peers from the net info.
+type peers struct {
+	sync.RWMutex
+	sortBy peerSort
+	peers  []coretypes.Peer
+}
+
+// set sets the node peers.
+func (p *peers) set(peers []coretypes.Peer) {
+	p.Lock()
+	defer p.Unlock()
+	p.peers = peers
+}
+
+// nextSort switches the table sort to the next column.
+func (p *peers) nextSort() {
+	p.Lock()
+	defer p.Unlock()
+	p.sortBy = (p.sortBy + 1) % (peerSortRecvRate + 1)
+}
+
+// formatRate formats a transfer rate in bytes per second.
+func formatRate(rate int64) string {
+	return number.ByteCountDecimal(rate) + "/s"
+}
+
+// String returns the peer table with the channels status of each peer.
+func (p *peers) String() string {
+	p.RLock()
+	defer p.RUnlock()
+
+	sorted := append([]coretypes.Peer(nil), p.peers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		switch p.sortBy {
+		case peerSortDirection:
+			if a.IsOutbound != b.IsOutbound {
+				return !a.IsOutbound
+			}
+		case peerSortSendRate:
+			if a.ConnectionStatus.SendMonitor.CurRate != b.ConnectionStatus.SendMonitor.CurRate {
+				return a.ConnectionStatus.SendMonitor.CurRate > b.ConnectionStatus.SendMonitor.CurRate
+			}
+		case peerSortRecvRate:
+			if a.ConnectionStatus.RecvMonitor.CurRate != b.ConnectionStatus.RecvMonitor.CurRate {
+				return a.ConnectionStatus.RecvMonitor.CurRate > b.ConnectionStatus.RecvMonitor.CurRate
+			}
+		case peerSortMoniker:
+		}
+		return a.NodeInfo.Moniker < b.NodeInfo.Moniker
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d peers, sort by %s (press P to change)\n\n", len(sorted), p.sortBy)
+	fmt.Fprintf(
+		&b,
+		"%-40s %-20s %-15s %-4s %-10s %12s %12s\n",
+		"NODE ID", "MONIKER", "REMOTE IP", "DIR", "VERSION", "SEND", "RECV",
+	)
+	for _, peer := range sorted {
+		direction := "in"
+		if peer.IsOutbound {
+			direction = "out"
+		}
+		status := peer.ConnectionStatus
+		fmt.Fprintf(
+			&b,
+			"%-40s %-20.20s %-15s %-4s %-10.10s %12s %12s\n",
+			peer.NodeInfo.ID(),
+			peer.NodeInfo.Moniker,
+			peer.RemoteIP,
+			direction,
+			peer.NodeInfo.Version,
+			formatRate(status.SendMonitor.CurRate),
+			formatRate(status.RecvMonitor.CurRate),
+		)
+
+		channels := make([]string, 0, len(status.Channels))
+		for _, channel := range status.Channels {
+			channels = append(channels, fmt.Sprintf(
+				"%#02x sent:%s queue:%d/%d",
+				channel.ID,
+				number.ByteCountDecimal(channel.RecentlySent),
+				channel.SendQueueSize,
+				channel.SendQueueCapacity,
+			))
+		}
+		if len(channels) > 0 {
+			fmt.Fprintf(&b, "  %s\n", strings.Join(channels, ", "))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+// proposerWindow is the number of latest blocks used to count the proposed blocks.
+const proposerWindow = 100
+
+// proposers tracks the block proposers over a rolling window of blocks.
+type proposers struct {
+	sync.RWMutex
+	window   *window.Window[string]
+	monikers *monikers
+}
+
+// proposerCount holds the number of blocks proposed by a validator.
+type proposerCount struct {
+	address string
+	blocks  int
+}
+
+// newProposers creates a new block proposers tracker.
+func newProposers(size int, monikers *monikers) *proposers {
+	return &proposers{
+		window:   window.New[string](size),
+		monikers: monikers,
+	}
+}
+
+// add adds a new block proposer address.
+func (p *proposers) add(address string) {
+	p.Lock()
+	defer p.Unlock()
+	p.window.Push(address)
+}
+
+// counts returns the number of blocks proposed by each validator sorted by the count.
+func (p *proposers) counts() []proposerCount {
+	p.RLock()
+	defer p.RUnlock()
+
+	blocks := make(map[string]int)
+	for _, address := range p.window.Values() {
+		blocks[address]++
+	}
+
+	counts := make([]proposerCount, 0, len(blocks))
+	for address, n := range blocks {
+		counts = append(counts, proposerCount{address: address, blocks: n})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].blocks == counts[j].blocks {
+			return counts[i].address < counts[j].address
+		}
+		return counts[i].blocks > counts[j].blocks
+	})
+	return counts
+}
+
+// String returns the proposed blocks count table.
+func (p *proposers) String() string {
+	var (
+		counts = p.counts()
+		total  = 0
+		b      strings.Builder
+	)
+	for _, count := range counts {
+		total += count.blocks
+	}
+
+	fmt.Fprintf(&b, "last %d blocks\n\n", total)
+	for _, count := range counts {
+		fmt.Fprintf(
+			&b,
+			"%-42s %6d %8s\n",
+			p.monikers.name(count.address),
+			count.blocks,
+			number.Percentage(int64(count.blocks), int64(total)),
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,129 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/widget"
+	"github.com/ignite/gex/pkg/window"
+)
+
+// roundsWindow is the number of latest heights listed with their rounds.
+const roundsWindow = 100
+
+// heightRounds holds the number of rounds and timeouts needed by a height.
+type heightRounds struct {
+	height   int64
+	rounds   int32
+	timeouts int
+}
+
+// rounds tracks the number of rounds and timeouts needed by each height.
+type rounds struct {
+	sync.RWMutex
+	heights           *window.Window[heightRounds]
+	current           heightRounds
+	totalHeights      int64
+	multiRoundHeights int64
+	timeoutPropose    int64
+	timeoutWait       int64
+
+	// render serializes the widget updates so stale lines never overwrite newer ones.
+	render sync.Mutex
+}
+
+// newRounds creates a new rounds tracker.
+func newRounds(size int) *rounds {
+	return &rounds{heights: window.New[heightRounds](size)}
+}
+
+// setRoundStep records the round of the height from a new round step event.
+// The current height is finished as soon as a greater height starts.
+func (r *rounds) setRoundStep(state types.EventDataRoundState) {
+	r.Lock()
+	defer r.Unlock()
+	r.moveTo(state.Height)
+	if state.Height == r.current.height && state.Round+1 > r.current.rounds {
+		r.current.rounds = state.Round + 1
+	}
+}
+
+// addTimeout records a timeout event of the current height.
+func (r *rounds) addTimeout(event string, state types.EventDataRoundState) {
+	r.Lock()
+	defer r.Unlock()
+	r.moveTo(state.Height)
+	if state.Height != r.current.height {
+		return
+	}
+	r.current.timeouts++
+	switch event {
+	case types.EventTimeoutPropose:
+		r.timeoutPropose++
+	case types.EventTimeoutWait:
+		r.timeoutWait++
+	}
+}
+
+// moveTo finishes the current height if the height is greater.
+// Caller must hold the lock.
+func (r *rounds) moveTo(height int64) {
+	if height <= r.current.height {
+		return
+	}
+	if r.current.height > 0 {
+		r.heights.Push(r.current)
+		r.totalHeights++
+		if r.current.rounds > 1 {
+			r.multiRoundHeights++
+		}
+	}
+	r.current = heightRounds{height: height, rounds: 1}
+}
+
+// lines returns the rounds summary and the latest heights, highlighting the heights with extra rounds.
+func (r *rounds) lines() []widget.Line {
+	r.RLock()
+	defer r.RUnlock()
+
+	lines := []widget.Line{
+		{Text: fmt.Sprintf(
+			"heights: %d, with extra rounds: %d (%s)",
+			r.totalHeights,
+			r.multiRoundHeights,
+			number.Percentage(r.multiRoundHeights, r.totalHeights),
+		)},
+		{Text: fmt.Sprintf("timeouts: propose %d, wait %d", r.timeoutPropose, r.timeoutWait)},
+		{
+			Text:  fmt.Sprintf("current: height %d round %d", r.current.height, r.current.rounds-1),
+			Alert: r.current.rounds > 1,
+		},
+	}
+	if last, ok := r.heights.Last(); ok && last.rounds > 1 {
+		lines = append(lines, widget.Line{
+			Text:  fmt.Sprintf("⚠ height %d needed %d rounds", last.height, last.rounds),
+			Alert: true,
+		})
+	}
+
+	lines = append(lines, widget.Line{Text: fmt.Sprintf("\n%-12s %8s %8s", "HEIGHT", "ROUNDS", "TIMEOUTS")})
+	heights := r.heights.Values()
+	for i := len(heights) - 1; i >= 0; i-- {
+		h := heights[i]
+		lines = append(lines, widget.Line{
+			Text:  fmt.Sprintf("%-12d %8d %8d", h.height, h.rounds, h.timeouts),
+			Alert: h.rounds > 1,
+		})
+	}
+	return lines
+}
+
+// updateRounds updates the rounds widget with the current rounds.
+func updateRounds(w *widget.Widget, r *rounds) error {
+	r.render.Lock()
+	defer r.render.Unlock()
+	return w.SetRounds(r.lines())
+}
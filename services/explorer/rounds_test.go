@@ -0,0 +1,97 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRounds(t *testing.T) {
+	type event struct {
+		timeout string
+		height  int64
+		round   int32
+	}
+	tests := []struct {
+		name               string
+		size               int
+		events             []event
+		wantHeights        []heightRounds
+		wantCurrent        heightRounds
+		wantMultiRound     int64
+		wantTimeoutPropose int64
+		wantTimeoutWait    int64
+	}{
+		{
+			name:        "no events",
+			size:        10,
+			wantHeights: []heightRounds{},
+		},
+		{
+			name:        "single round heights",
+			size:        10,
+			events:      []event{{"", 1, 0}, {"", 2, 0}, {"", 3, 0}},
+			wantHeights: []heightRounds{{1, 1, 0}, {2, 1, 0}},
+			wantCurrent: heightRounds{3, 1, 0},
+		},
+		{
+			name: "extra rounds and timeouts",
+			size: 10,
+			events: []event{
+				{"", 1, 0},
+				{types.EventTimeoutPropose, 1, 0},
+				{"", 1, 1},
+				{types.EventTimeoutWait, 1, 1},
+				{"", 1, 2},
+				{"", 2, 0},
+			},
+			wantHeights:        []heightRounds{{1, 3, 2}},
+			wantCurrent:        heightRounds{2, 1, 0},
+			wantMultiRound:     1,
+			wantTimeoutPropose: 1,
+			wantTimeoutWait:    1,
+		},
+		{
+			name:        "stale events are ignored",
+			size:        10,
+			events:      []event{{"", 2, 0}, {"", 1, 3}, {types.EventTimeoutPropose, 1, 0}},
+			wantHeights: []heightRounds{},
+			wantCurrent: heightRounds{2, 1, 0},
+		},
+		{
+			name:               "timeout starts a new height",
+			size:               10,
+			events:             []event{{"", 1, 0}, {types.EventTimeoutPropose, 2, 0}},
+			wantHeights:        []heightRounds{{1, 1, 0}},
+			wantCurrent:        heightRounds{2, 1, 1},
+			wantTimeoutPropose: 1,
+		},
+		{
+			name:           "rolled heights",
+			size:           2,
+			events:         []event{{"", 1, 0}, {"", 2, 1}, {"", 3, 0}, {"", 4, 0}},
+			wantHeights:    []heightRounds{{2, 2, 0}, {3, 1, 0}},
+			wantCurrent:    heightRounds{4, 1, 0},
+			wantMultiRound: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRounds(tt.size)
+			for _, e := range tt.events {
+				state := types.EventDataRoundState{Height: e.height, Round: e.round}
+				if e.timeout != "" {
+					r.addTimeout(e.timeout, state)
+					continue
+				}
+				r.setRoundStep(state)
+			}
+			require.Equal(t, tt.wantHeights, r.heights.Values())
+			require.Equal(t, tt.wantCurrent, r.current)
+			require.Equal(t, tt.wantMultiRound, r.multiRoundHeights)
+			require.Equal(t, tt.wantTimeoutPropose, r.timeoutPropose)
+			require.Equal(t, tt.wantTimeoutWait, r.timeoutWait)
+		})
+	}
+}
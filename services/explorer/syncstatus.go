@@ -0,0 +1,118 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/widget"
+	"github.com/ignite/gex/pkg/window"
+)
+
+// syncWindow is the number of status samples used to compute the catch-up rate.
+const syncWindow = 60
+
+// syncSample holds the node latest block at a point in time.
+type syncSample struct {
+	at        time.Time
+	height    int64
+	blockTime time.Time
+}
+
+// syncStatus tracks the node sync info and its catch-up progress.
+type syncStatus struct {
+	sync.RWMutex
+	info    coretypes.SyncInfo
+	err     error
+	samples *window.Window[syncSample]
+}
+
+// newSyncStatus creates a new node sync status tracker.
+func newSyncStatus(size int) *syncStatus {
+	return &syncStatus{samples: window.New[syncSample](size)}
+}
+
+// set sets the node sync info observed at the given time.
+// The samples are kept on error, so the rates resume once the node is reachable.
+func (s *syncStatus) set(info coretypes.SyncInfo, err error, now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.err = err
+	if err != nil {
+		return
+	}
+	s.info = info
+	s.samples.Push(syncSample{
+		at:        now,
+		height:    info.LatestBlockHeight,
+		blockTime: info.LatestBlockTime,
+	})
+}
+
+// rates returns the number of blocks synced per second of wall-clock time and
+// the number of blocks produced by the network per second of block time.
+// The rates are zero if the latest height went back, e.g. after a node rollback.
+// Caller must hold the lock.
+func (s *syncStatus) rates() (syncRate, chainRate float64) {
+	samples := s.samples.Values()
+	if len(samples) < 2 {
+		return 0, 0
+	}
+	var (
+		first, last = samples[0], samples[len(samples)-1]
+		blocks      = float64(last.height - first.height)
+	)
+	if blocks <= 0 {
+		return 0, 0
+	}
+	if elapsed := last.at.Sub(first.at).Seconds(); elapsed > 0 {
+		syncRate = blocks / elapsed
+	}
+	if elapsed := last.blockTime.Sub(first.blockTime).Seconds(); elapsed > 0 {
+		chainRate = blocks / elapsed
+	}
+	return syncRate, chainRate
+}
+
+// lines returns the node sync status, or the error as an alert line if the node is not reachable.
+func (s *syncStatus) lines(now time.Time) []widget.Line {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.err != nil {
+		return []widget.Line{{Text: fmt.Sprintf("node status not reachable: %s", s.err), Alert: true}}
+	}
+
+	behind := now.Sub(s.info.LatestBlockTime).Truncate(time.Second)
+	lines := []widget.Line{
+		{Text: fmt.Sprintf("catching up: %t", s.info.CatchingUp)},
+		{Text: fmt.Sprintf("latest block: %s", number.WithComma(s.info.LatestBlockHeight))},
+		{Text: fmt.Sprintf("latest block time: %s", s.info.LatestBlockTime.Format(time.DateTime))},
+		{Text: fmt.Sprintf("behind wall-clock: %s", behind)},
+		{Text: fmt.Sprintf("earliest block: %s", number.WithComma(s.info.EarliestBlockHeight))},
+	}
+	if !s.info.CatchingUp {
+		return lines
+	}
+
+	syncRate, chainRate := s.rates()
+	lines = append(lines, widget.Line{}, widget.Line{Text: fmt.Sprintf("sync rate: %.2f blocks/s", syncRate)})
+
+	// The network tip is estimated from the block rate observed in the synced
+	// blocks, and it keeps moving while the node catches up.
+	if syncRate <= chainRate || chainRate == 0 {
+		return append(lines, widget.Line{Text: "eta: unknown"})
+	}
+	var (
+		remaining = behind.Seconds() * chainRate
+		eta       = time.Duration(remaining / (syncRate - chainRate) * float64(time.Second))
+	)
+	return append(
+		lines,
+		widget.Line{Text: fmt.Sprintf("estimated tip: %s", number.WithComma(s.info.LatestBlockHeight+int64(remaining)))},
+		widget.Line{Text: fmt.Sprintf("eta: %s", eta.Truncate(time.Second))},
+	)
+}
@@ -0,0 +1,125 @@
+package explorer
+
+import (
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type sample struct {
+		at        time.Duration
+		height    int64
+		blockTime time.Duration
+	}
+	tests := []struct {
+		name          string
+		catchingUp    bool
+		samples       []sample
+		err           error
+		wantSyncRate  float64
+		wantChainRate float64
+		want          []string
+	}{
+		{
+			name:    "synced",
+			samples: []sample{{0, 1000, -5 * time.Second}},
+			want: []string{
+				"catching up: false",
+				"latest block: 1,000",
+				"latest block time: 2023-12-31 23:59:55",
+				"behind wall-clock: 5s",
+				"earliest block: 1",
+			},
+		},
+		{
+			name:       "single sample has no rate",
+			catchingUp: true,
+			samples:    []sample{{0, 1000, -time.Hour}},
+			want:       []string{"", "sync rate: 0.00 blocks/s", "eta: unknown"},
+		},
+		{
+			name:       "catching up",
+			catchingUp: true,
+			samples: []sample{
+				{-10 * time.Second, 1000, -1500 * time.Second},
+				{0, 1100, -1000 * time.Second},
+			},
+			wantSyncRate:  10,
+			wantChainRate: 0.2,
+			want:          []string{"", "sync rate: 10.00 blocks/s", "estimated tip: 1,300", "eta: 20s"},
+		},
+		{
+			name:       "slower than the chain",
+			catchingUp: true,
+			samples: []sample{
+				{-100 * time.Second, 1000, -1500 * time.Second},
+				{0, 1010, -1490 * time.Second},
+			},
+			wantSyncRate:  0.1,
+			wantChainRate: 1,
+			want:          []string{"", "sync rate: 0.10 blocks/s", "eta: unknown"},
+		},
+		{
+			name:       "same block times have no chain rate",
+			catchingUp: true,
+			samples: []sample{
+				{-10 * time.Second, 1000, -time.Hour},
+				{0, 1100, -time.Hour},
+			},
+			wantSyncRate: 10,
+			want:         []string{"", "sync rate: 10.00 blocks/s", "eta: unknown"},
+		},
+		{
+			name:       "height going back has no rate",
+			catchingUp: true,
+			samples: []sample{
+				{-10 * time.Second, 1100, -1000 * time.Second},
+				{0, 1000, -1500 * time.Second},
+			},
+			want: []string{"", "sync rate: 0.00 blocks/s", "eta: unknown"},
+		},
+		{
+			name:    "node not reachable",
+			samples: []sample{{0, 1000, 0}},
+			err:     errors.New("connection refused"),
+			want:    []string{"node status not reachable: connection refused"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSyncStatus(syncWindow)
+			for _, sample := range tt.samples {
+				s.set(coretypes.SyncInfo{
+					CatchingUp:          tt.catchingUp,
+					LatestBlockHeight:   sample.height,
+					LatestBlockTime:     now.Add(sample.blockTime),
+					EarliestBlockHeight: 1,
+				}, nil, now.Add(sample.at))
+			}
+			if tt.err != nil {
+				s.set(coretypes.SyncInfo{}, tt.err, now)
+			}
+
+			syncRate, chainRate := s.rates()
+			require.InDelta(t, tt.wantSyncRate, syncRate, 1e-9)
+			require.InDelta(t, tt.wantChainRate, chainRate, 1e-9)
+
+			lines := s.lines(now)
+			got := make([]string, 0, len(lines))
+			for _, line := range lines {
+				got = append(got, line.Text)
+			}
+			if tt.catchingUp {
+				// Skip the node info lines.
+				got = got[5:]
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/gex/pkg/window"
+)
+
+// throughputWindow is the number of latest blocks kept to compute the throughput,
+// enough to cover the longest rate period with sub-second block times.
+const throughputWindow = 5_000
+
+// blockTxs holds the number of transactions and the header time of a block.
+type blockTxs struct {
+	time time.Time
+	txs  int
+}
+
+// throughput tracks the transactions per second from the block headers.
+type throughput struct {
+	sync.RWMutex
+	blocks     *window.Window[blockTxs]
+	lastHeight int64
+	peak       float64
+}
+
+// newThroughput creates a new throughput tracker.
+func newThroughput(size int) *throughput {
+	return &throughput{blocks: window.New[blockTxs](size)}
+}
+
+// add adds a new block with its height, header time and number of transactions.
+// The window restarts from the block if heights were missed since the previous
+// one, since the transactions of the missed blocks are unknown.
+func (t *throughput) add(height int64, blockTime time.Time, txs int) {
+	t.Lock()
+	defer t.Unlock()
+	if height <= t.lastHeight {
+		return
+	}
+	if t.lastHeight > 0 && height != t.lastHeight+1 {
+		t.blocks = window.New[blockTxs](t.blocks.Size())
+	}
+	t.lastHeight = height
+	t.blocks.Push(blockTxs{time: blockTime, txs: txs})
+	if tps := t.rate(0); tps > t.peak {
+		t.peak = tps
+	}
+}
+
+// rate returns the transactions per second of the blocks inside the period ending
+// at the latest block. A zero period returns the latest block rate.
+// Caller must hold the lock.
+func (t *throughput) rate(period time.Duration) float64 {
+	blocks := t.blocks.Values()
+	if len(blocks) < 2 {
+		return 0
+	}
+
+	var (
+		last  = blocks[len(blocks)-1]
+		first = blocks[len(blocks)-2]
+		txs   = last.txs
+	)
+	// The first block of the period only marks the period start, its
+	// transactions were produced before it.
+	for i := len(blocks) - 2; i > 0 && last.time.Sub(blocks[i-1].time) <= period; i-- {
+		txs += blocks[i].txs
+		first = blocks[i-1]
+	}
+
+	elapsed := last.time.Sub(first.time).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(txs) / elapsed
+}
+
+// String returns the throughput summary.
+func (t *throughput) String() string {
+	t.RLock()
+	defer t.RUnlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "instant: %.2f tx/s\n", t.rate(0))
+	fmt.Fprintf(&b, "1 minute: %.2f tx/s\n", t.rate(time.Minute))
+	fmt.Fprintf(&b, "10 minutes: %.2f tx/s\n", t.rate(10*time.Minute))
+	fmt.Fprintf(&b, "peak: %.2f tx/s\n", t.peak)
+	return b.String()
+}
@@ -0,0 +1,95 @@
+package explorer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThroughputRate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		blocks   []blockTxs
+		period   time.Duration
+		want     float64
+		wantPeak float64
+	}{
+		{
+			name:   "no blocks",
+			period: time.Minute,
+		},
+		{
+			name:   "single block",
+			blocks: []blockTxs{{start, 10}},
+			period: time.Minute,
+		},
+		{
+			name:     "latest block rate",
+			blocks:   []blockTxs{{start, 100}, {start.Add(2 * time.Second), 10}},
+			want:     5,
+			wantPeak: 5,
+		},
+		{
+			name: "period rate skips the transactions of the first block",
+			blocks: []blockTxs{
+				{start, 100},
+				{start.Add(5 * time.Second), 10},
+				{start.Add(10 * time.Second), 20},
+			},
+			period:   time.Minute,
+			want:     3,
+			wantPeak: 4,
+		},
+		{
+			name: "period rate ignores the blocks before the period",
+			blocks: []blockTxs{
+				{start, 1000},
+				{start.Add(time.Minute), 1000},
+				{start.Add(time.Minute + 10*time.Second), 10},
+				{start.Add(time.Minute + 20*time.Second), 30},
+			},
+			period:   30 * time.Second,
+			want:     2,
+			wantPeak: 1000.0 / 60,
+		},
+		{
+			name:   "same block times",
+			blocks: []blockTxs{{start, 1}, {start, 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tps := newThroughput(10)
+			for i, block := range tt.blocks {
+				tps.add(int64(i+1), block.time, block.txs)
+			}
+			require.InDelta(t, tt.want, tps.rate(tt.period), 1e-9)
+			require.InDelta(t, tt.wantPeak, tps.peak, 1e-9)
+		})
+	}
+}
+
+func TestThroughputMissedBlocks(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		heights []int64
+		want    float64
+	}{
+		{"consecutive heights", []int64{1, 2, 3}, 5},
+		{"missed heights restart the window", []int64{1, 2, 5}, 0},
+		{"window restarts from the block after the gap", []int64{1, 4, 5}, 6},
+		{"older heights are ignored", []int64{1, 2, 2}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tps := newThroughput(10)
+			for i, height := range tt.heights {
+				tps.add(height, start.Add(time.Duration(i)*5*time.Second), 10*(i+1))
+			}
+			require.InDelta(t, tt.want, tps.rate(time.Minute), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package explorer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/widget"
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// upgradeRefreshInterval is the number of blocks between upgrade plan queries.
+	upgradeRefreshInterval = 10
+
+	// upgradeWindow is the number of latest blocks used to compute the block time.
+	upgradeWindow = 100
+
+	// upgradeBannerBlocks is the number of blocks before the upgrade height
+	// from which the upgrade is shown as a banner on every page.
+	upgradeBannerBlocks = 300
+)
+
+// upgrade tracks the scheduled upgrade plan and the blocks left until the chain halts.
+type upgrade struct {
+	sync.RWMutex
+	plan   *upgradetypes.Plan
+	err    error
+	height int64
+	times  *window.Window[time.Time]
+}
+
+// newUpgrade creates a new scheduled upgrade tracker.
+func newUpgrade(size int) *upgrade {
+	return &upgrade{times: window.New[time.Time](size)}
+}
+
+// setPlan sets the current upgrade plan, nil when no upgrade is scheduled.
+func (u *upgrade) setPlan(plan *upgradetypes.Plan, err error) {
+	u.Lock()
+	defer u.Unlock()
+	u.plan = plan
+	u.err = err
+}
+
+// addBlock adds a new block height and header time.
+func (u *upgrade) addBlock(height int64, blockTime time.Time) {
+	u.Lock()
+	defer u.Unlock()
+	u.height = height
+	u.times.Push(blockTime)
+}
+
+// blockTime returns the average block time from the block headers.
+// Caller must hold the lock.
+func (u *upgrade) blockTime() time.Duration {
+	times := u.times.Values()
+	if len(times) < 2 {
+		return 0
+	}
+	return times[len(times)-1].Sub(times[0]) / time.Duration(len(times)-1)
+}
+
+// remaining returns the number of blocks left to commit before the chain halts and its ETA.
+// The old binary halts without committing the upgrade height, so the last block is the
+// one before it. Caller must hold the lock.
+func (u *upgrade) remaining() (int64, time.Duration) {
+	blocks := u.plan.Height - 1 - u.height
+	if blocks < 0 {
+		blocks = 0
+	}
+	return blocks, time.Duration(blocks) * u.blockTime()
+}
+
+// banner returns the upgrade banner when the upgrade height is close, otherwise an empty string.
+func (u *upgrade) banner() string {
+	u.RLock()
+	defer u.RUnlock()
+
+	if u.plan == nil || u.height == 0 {
+		return ""
+	}
+	blocks, eta := u.remaining()
+	switch {
+	case blocks > upgradeBannerBlocks:
+		return ""
+	case blocks == 0:
+		return fmt.Sprintf("⚠ UPGRADE %s: HALT HEIGHT %d REACHED", u.plan.Name, u.plan.Height)
+	default:
+		return fmt.Sprintf(
+			"⚠ UPGRADE %s IN %d BLOCKS (~%s)",
+			u.plan.Name,
+			blocks,
+			eta.Truncate(time.Second),
+		)
+	}
+}
+
+// lines returns the upgrade plan details, highlighted when the upgrade height is close.
+func (u *upgrade) lines() []widget.Line {
+	u.RLock()
+	defer u.RUnlock()
+
+	switch {
+	case u.err != nil:
+		return []widget.Line{{Text: fmt.Sprintf("upgrade module not reachable: %s", u.err)}}
+	case u.plan == nil:
+		return []widget.Line{{Text: "no upgrade scheduled"}}
+	}
+
+	lines := []widget.Line{
+		{Text: fmt.Sprintf("name: %s", u.plan.Name)},
+		{Text: fmt.Sprintf("height: %s", number.WithComma(u.plan.Height))},
+	}
+	if u.plan.Info != "" {
+		lines = append(lines, widget.Line{Text: fmt.Sprintf("info: %s", u.plan.Info)})
+	}
+	if u.height == 0 {
+		return lines
+	}
+
+	var (
+		blocks, eta = u.remaining()
+		blockTime   = u.blockTime()
+		alert       = blocks <= upgradeBannerBlocks
+	)
+	lines = append(lines, widget.Line{Text: fmt.Sprintf("\ncurrent height: %s", number.WithComma(u.height))})
+	if blocks == 0 {
+		return append(lines, widget.Line{
+			Text:  "⚠ upgrade height reached, the chain halts until the nodes run the new binary",
+			Alert: true,
+		})
+	}
+	lines = append(lines, widget.Line{
+		Text:  fmt.Sprintf("remaining blocks: %s", number.WithComma(blocks)),
+		Alert: alert,
+	})
+	if blockTime == 0 {
+		return append(lines, widget.Line{Text: "eta: unknown"})
+	}
+	return append(lines,
+		widget.Line{Text: fmt.Sprintf("block time: %.2f seconds", blockTime.Seconds())},
+		widget.Line{
+			Text: fmt.Sprintf(
+				"eta: %s (%s)",
+				eta.Truncate(time.Second),
+				time.Now().Add(eta).Format(time.DateTime),
+			),
+			Alert: alert,
+		},
+	)
+}
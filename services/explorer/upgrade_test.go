@@ -0,0 +1,64 @@
+package explorer
+
+import (
+	"testing"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeRemaining(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		height     int64
+		wantBlocks int64
+		wantETA    time.Duration
+		wantBanner string
+	}{
+		{
+			name:       "far from the upgrade",
+			height:     500,
+			wantBlocks: 499,
+			wantETA:    499 * 5 * time.Second,
+		},
+		{
+			name:       "close to the upgrade",
+			height:     900,
+			wantBlocks: 99,
+			wantETA:    99 * 5 * time.Second,
+			wantBanner: "⚠ UPGRADE v2 IN 99 BLOCKS (~8m15s)",
+		},
+		{
+			name:       "last block before the upgrade height",
+			height:     998,
+			wantBlocks: 1,
+			wantETA:    5 * time.Second,
+			wantBanner: "⚠ UPGRADE v2 IN 1 BLOCKS (~5s)",
+		},
+		{
+			name:       "halted before the upgrade height",
+			height:     999,
+			wantBanner: "⚠ UPGRADE v2: HALT HEIGHT 1000 REACHED",
+		},
+		{
+			name:       "upgrade height passed",
+			height:     1200,
+			wantBanner: "⚠ UPGRADE v2: HALT HEIGHT 1000 REACHED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newUpgrade(10)
+			u.setPlan(&upgradetypes.Plan{Name: "v2", Height: 1000}, nil)
+			u.addBlock(tt.height-1, start)
+			u.addBlock(tt.height, start.Add(5*time.Second))
+
+			blocks, eta := u.remaining()
+			require.Equal(t, tt.wantBlocks, blocks)
+			require.Equal(t, tt.wantETA, eta)
+			require.Equal(t, tt.wantBanner, u.banner())
+		})
+	}
+}
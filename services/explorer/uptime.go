@@ -0,0 +1,218 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// uptimeWindow is the number of latest commits used to compute the validator uptime.
+	uptimeWindow = 100
+
+	// uptimeQueueSize is the number of pending commits kept while their validator set is fetched.
+	uptimeQueueSize = 100
+)
+
+// uptimeCommit holds a block last commit and the hash of the validator set of the
+// commit height, nil if unknown.
+type uptimeCommit struct {
+	commit         *types.Commit
+	validatorsHash []byte
+}
+
+// signStatus represents the validator participation in a commit.
+type signStatus int
+
+const (
+	signStatusSigned signStatus = iota
+	signStatusMissed
+	signStatusAbsent
+)
+
+// uptimeSort represents the uptime table sort column.
+type uptimeSort int
+
+const (
+	uptimeSortUptime uptimeSort = iota
+	uptimeSortStreak
+	uptimeSortValidator
+)
+
+// String returns the uptime sort column name.
+func (s uptimeSort) String() string {
+	switch s {
+	case uptimeSortUptime:
+		return "uptime"
+	case uptimeSortStreak:
+		return "missed streak"
+	case uptimeSortValidator:
+		return "validator"
+	default:
+		return "unknown"
+	}
+}
+
+// validatorUptime holds the latest sign status of a validator.
+type validatorUptime struct {
+	address    string
+	window     *window.Window[signStatus]
+	streak     int
+	maxStreak  int
+	lastHeight int64
+}
+
+// count returns the number of commits with the sign status inside the window.
+func (v *validatorUptime) count(status signStatus) int {
+	n := 0
+	for _, s := range v.window.Values() {
+		if s == status {
+			n++
+		}
+	}
+	return n
+}
+
+// ratio returns the ratio of signed commits inside the window.
+func (v *validatorUptime) ratio() float64 {
+	if v.window.Len() == 0 {
+		return 0
+	}
+	return float64(v.count(signStatusSigned)) / float64(v.window.Len())
+}
+
+// uptime tracks the validators signing status from the block last commits.
+type uptime struct {
+	sync.RWMutex
+	size       int
+	sortBy     uptimeSort
+	lastHeight int64
+	validators map[string]*validatorUptime
+	monikers   *monikers
+}
+
+// newUptime creates a new validator uptime tracker.
+func newUptime(size int, monikers *monikers) *uptime {
+	return &uptime{
+		size:       size,
+		validators: make(map[string]*validatorUptime),
+		monikers:   monikers,
+	}
+}
+
+// add adds the commit signatures of the validator set at the commit height.
+// The commit signatures are ordered like the validator set, which allows
+// identifying absent validators without a signature address.
+func (u *uptime) add(commit *types.Commit, validators []*types.Validator) {
+	if commit == nil || len(commit.Signatures) != len(validators) {
+		return
+	}
+
+	u.Lock()
+	defer u.Unlock()
+
+	if commit.Height <= u.lastHeight {
+		return
+	}
+	u.lastHeight = commit.Height
+
+	for i, sig := range commit.Signatures {
+		address := validators[i].Address.String()
+		v, ok := u.validators[address]
+		if !ok {
+			v = &validatorUptime{address: address, window: window.New[signStatus](u.size)}
+			u.validators[address] = v
+		}
+
+		var status signStatus
+		switch sig.BlockIDFlag {
+		case types.BlockIDFlagCommit:
+			status = signStatusSigned
+		case types.BlockIDFlagNil:
+			status = signStatusMissed
+		case types.BlockIDFlagAbsent:
+			status = signStatusAbsent
+		default:
+			continue
+		}
+		v.window.Push(status)
+		v.lastHeight = commit.Height
+
+		if status == signStatusSigned {
+			v.streak = 0
+			continue
+		}
+		v.streak++
+		if v.streak > v.maxStreak {
+			v.maxStreak = v.streak
+		}
+	}
+
+	// Drop the validators out of the set for longer than the window.
+	for address, v := range u.validators {
+		if u.lastHeight-v.lastHeight >= int64(u.size) {
+			delete(u.validators, address)
+		}
+	}
+}
+
+// nextSort switches the table sort to the next column.
+func (u *uptime) nextSort() {
+	u.Lock()
+	defer u.Unlock()
+	u.sortBy = (u.sortBy + 1) % (uptimeSortValidator + 1)
+}
+
+// String returns the validator uptime table.
+func (u *uptime) String() string {
+	u.RLock()
+	defer u.RUnlock()
+
+	validators := make([]*validatorUptime, 0, len(u.validators))
+	for _, v := range u.validators {
+		validators = append(validators, v)
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		a, b := validators[i], validators[j]
+		switch u.sortBy {
+		case uptimeSortUptime:
+			if a.ratio() != b.ratio() {
+				return a.ratio() < b.ratio()
+			}
+		case uptimeSortStreak:
+			if a.streak != b.streak {
+				return a.streak > b.streak
+			}
+		case uptimeSortValidator:
+		}
+		return u.monikers.name(a.address) < u.monikers.name(b.address)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "last %d commits, sort by %s (press U to change)\n\n", u.size, u.sortBy)
+	fmt.Fprintf(
+		&b,
+		"%-40s %8s %6s %6s %6s %6s %6s\n",
+		"VALIDATOR", "UPTIME", "SIGNED", "MISSED", "ABSENT", "STREAK", "MAX",
+	)
+	for _, v := range validators {
+		fmt.Fprintf(
+			&b,
+			"%-40.40s %8s %6d %6d %6d %6d %6d\n",
+			u.monikers.name(v.address),
+			number.Percentage(int64(v.count(signStatusSigned)), int64(v.window.Len())),
+			v.count(signStatusSigned),
+			v.count(signStatusMissed),
+			v.count(signStatusAbsent),
+			v.streak,
+			v.maxStreak,
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,145 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUptime(t *testing.T) {
+	const (
+		signed = types.BlockIDFlagCommit
+		missed = types.BlockIDFlagNil
+		absent = types.BlockIDFlagAbsent
+	)
+	type commit struct {
+		height     int64
+		validators []byte
+		flags      []types.BlockIDFlag
+	}
+	type status struct {
+		signed, missed, absent, streak, maxStreak int
+	}
+	tests := []struct {
+		name    string
+		size    int
+		commits []commit
+		want    map[string]status
+	}{
+		{
+			name: "no commits",
+			size: 10,
+			want: map[string]status{},
+		},
+		{
+			name: "missed streak",
+			size: 10,
+			commits: []commit{
+				{1, []byte{1}, []types.BlockIDFlag{signed}},
+				{2, []byte{1}, []types.BlockIDFlag{missed}},
+				{3, []byte{1}, []types.BlockIDFlag{absent}},
+				{4, []byte{1}, []types.BlockIDFlag{absent}},
+			},
+			want: map[string]status{"01": {1, 1, 2, 3, 3}},
+		},
+		{
+			name: "signed commit resets the streak",
+			size: 10,
+			commits: []commit{
+				{1, []byte{1, 2}, []types.BlockIDFlag{absent, signed}},
+				{2, []byte{1, 2}, []types.BlockIDFlag{absent, missed}},
+				{3, []byte{1, 2}, []types.BlockIDFlag{signed, signed}},
+			},
+			want: map[string]status{
+				"01": {1, 0, 2, 0, 2},
+				"02": {2, 1, 0, 0, 1},
+			},
+		},
+		{
+			name: "window keeps the latest commits",
+			size: 3,
+			commits: []commit{
+				{1, []byte{1}, []types.BlockIDFlag{absent}},
+				{2, []byte{1}, []types.BlockIDFlag{absent}},
+				{3, []byte{1}, []types.BlockIDFlag{signed}},
+				{4, []byte{1}, []types.BlockIDFlag{missed}},
+				{5, []byte{1}, []types.BlockIDFlag{signed}},
+			},
+			want: map[string]status{"01": {2, 1, 0, 0, 2}},
+		},
+		{
+			name: "validator kept inside the window",
+			size: 3,
+			commits: []commit{
+				{1, []byte{1, 2}, []types.BlockIDFlag{signed, signed}},
+				{2, []byte{1}, []types.BlockIDFlag{signed}},
+				{3, []byte{1}, []types.BlockIDFlag{signed}},
+			},
+			want: map[string]status{
+				"01": {3, 0, 0, 0, 0},
+				"02": {1, 0, 0, 0, 0},
+			},
+		},
+		{
+			name: "validator evicted out of the window",
+			size: 3,
+			commits: []commit{
+				{1, []byte{1, 2}, []types.BlockIDFlag{signed, signed}},
+				{2, []byte{1}, []types.BlockIDFlag{signed}},
+				{3, []byte{1}, []types.BlockIDFlag{signed}},
+				{4, []byte{1}, []types.BlockIDFlag{signed}},
+			},
+			want: map[string]status{"01": {3, 0, 0, 0, 0}},
+		},
+		{
+			name: "stale commits are ignored",
+			size: 10,
+			commits: []commit{
+				{2, []byte{1}, []types.BlockIDFlag{signed}},
+				{2, []byte{1}, []types.BlockIDFlag{missed}},
+				{1, []byte{1}, []types.BlockIDFlag{missed}},
+			},
+			want: map[string]status{"01": {1, 0, 0, 0, 0}},
+		},
+		{
+			name: "commits not matching the validator set are ignored",
+			size: 10,
+			commits: []commit{
+				{1, []byte{1}, []types.BlockIDFlag{signed}},
+				{2, []byte{1}, []types.BlockIDFlag{signed, missed}},
+			},
+			want: map[string]status{"01": {1, 0, 0, 0, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newUptime(tt.size, newMonikers())
+			for _, c := range tt.commits {
+				var (
+					commit     = &types.Commit{Height: c.height}
+					validators []*types.Validator
+				)
+				for _, address := range c.validators {
+					validators = append(validators, &types.Validator{Address: []byte{address}, VotingPower: 10})
+				}
+				for _, flag := range c.flags {
+					commit.Signatures = append(commit.Signatures, types.CommitSig{BlockIDFlag: flag})
+				}
+				u.add(commit, validators)
+			}
+
+			got := make(map[string]status, len(u.validators))
+			for address, v := range u.validators {
+				got[address] = status{
+					signed:    v.count(signStatusSigned),
+					missed:    v.count(signStatusMissed),
+					absent:    v.count(signStatusAbsent),
+					streak:    v.streak,
+					maxStreak: v.maxStreak,
+				}
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package explorer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/number"
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// validatorSetChangesSize is the number of validator set changes kept for the export.
+	validatorSetChangesSize = 10_000
+
+	// validatorSetChangesShown is the number of latest validator set changes shown.
+	validatorSetChangesShown = 100
+
+	// validatorSetMaxGap is the max number of missed heights fetched to keep diffing
+	// consecutive validator sets, the diff restarting from the latest set beyond it.
+	validatorSetMaxGap = 100
+)
+
+// validatorSetChange holds a validator change between two consecutive validator sets.
+type validatorSetChange struct {
+	height   int64
+	address  string
+	oldPower int64
+	newPower int64
+}
+
+// kind returns the validator change kind.
+func (c validatorSetChange) kind() string {
+	switch {
+	case c.oldPower == 0:
+		return "added"
+	case c.newPower == 0:
+		return "removed"
+	default:
+		return "power"
+	}
+}
+
+// validatorSet tracks the validator set changes between consecutive heights.
+type validatorSet struct {
+	sync.RWMutex
+	height   int64
+	powers   map[string]int64
+	changes  *window.Window[validatorSetChange]
+	exported string
+	monikers *monikers
+}
+
+// newValidatorSet creates a new validator set changes tracker.
+func newValidatorSet(size int, monikers *monikers) *validatorSet {
+	return &validatorSet{
+		changes:  window.New[validatorSetChange](size),
+		monikers: monikers,
+	}
+}
+
+// heights returns the heights of the validator sets to set in order to diff
+// the consecutive sets up to the height.
+func (s *validatorSet) heights(height int64) []int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	from := s.height + 1
+	if s.height == 0 || height-s.height > validatorSetMaxGap {
+		from = height
+	}
+	heights := make([]int64, 0, max(height-from+1, 0))
+	for h := from; h <= height; h++ {
+		heights = append(heights, h)
+	}
+	return heights
+}
+
+// set sets the validator set of the height and records the changes since the previous set.
+// Only the set following the previous height is diffed, the others are ignored if
+// older or replace the previous set otherwise.
+func (s *validatorSet) set(height int64, validators []*types.Validator) {
+	powers := make(map[string]int64, len(validators))
+	for _, validator := range validators {
+		powers[validator.Address.String()] = validator.VotingPower
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if height <= s.height {
+		return
+	}
+	if s.powers != nil && height == s.height+1 {
+		changes := make([]validatorSetChange, 0)
+		for address, power := range powers {
+			if oldPower := s.powers[address]; oldPower != power {
+				changes = append(changes, validatorSetChange{height, address, oldPower, power})
+			}
+		}
+		for address, oldPower := range s.powers {
+			if _, ok := powers[address]; !ok {
+				changes = append(changes, validatorSetChange{height, address, oldPower, 0})
+			}
+		}
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].address < changes[j].address
+		})
+		for _, change := range changes {
+			s.changes.Push(change)
+		}
+	}
+	s.height = height
+	s.powers = powers
+}
+
+// export writes all the recorded changes to a CSV file in the current directory
+// and returns the file name.
+func (s *validatorSet) export(now time.Time) (string, error) {
+	s.RLock()
+	changes := s.changes.Values()
+	s.RUnlock()
+
+	name := fmt.Sprintf("gex-validator-set-changes-%s.csv", now.Format("20060102-150405"))
+	f, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	if err := writeValidatorSetChanges(f, changes, s.monikers); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return name, f.Close()
+}
+
+// writeValidatorSetChanges writes the validator set changes as CSV.
+func writeValidatorSetChanges(out io.Writer, changes []validatorSetChange, monikers *monikers) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"height", "change", "address", "moniker", "old_power", "new_power"}); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if err := w.Write([]string{
+			strconv.FormatInt(change.height, 10),
+			change.kind(),
+			change.address,
+			monikers.name(change.address),
+			strconv.FormatInt(change.oldPower, 10),
+			strconv.FormatInt(change.newPower, 10),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// setExported sets the export result shown on top of the changes.
+func (s *validatorSet) setExported(name string, err error) {
+	s.Lock()
+	defer s.Unlock()
+	if err != nil {
+		s.exported = fmt.Sprintf("export failed: %s", err)
+		return
+	}
+	s.exported = fmt.Sprintf("exported to %s", name)
+}
+
+// String returns the latest validator set changes from the newest.
+func (s *validatorSet) String() string {
+	s.RLock()
+	defer s.RUnlock()
+
+	changes := s.changes.Values()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d changes, latest set at height %d (press E to export)\n", len(changes), s.height)
+	if s.exported != "" {
+		fmt.Fprintf(&b, "%s\n", s.exported)
+	}
+	fmt.Fprintf(&b, "\n%-10s %-8s %-20s %15s %15s\n", "HEIGHT", "CHANGE", "VALIDATOR", "OLD POWER", "NEW POWER")
+	for i := len(changes) - 1; i >= 0 && i >= len(changes)-validatorSetChangesShown; i-- {
+		change := changes[i]
+		fmt.Fprintf(
+			&b,
+			"%-10d %-8s %-20.20s %15s %15s\n",
+			change.height,
+			change.kind(),
+			s.monikers.name(change.address),
+			number.WithComma(change.oldPower),
+			number.WithComma(change.newPower),
+		)
+	}
+	return b.String()
+}
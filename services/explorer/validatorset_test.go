@@ -0,0 +1,120 @@
+package explorer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorSetSet(t *testing.T) {
+	validator := func(address byte, power int64) *types.Validator {
+		return &types.Validator{Address: []byte{address}, VotingPower: power}
+	}
+	type set struct {
+		height     int64
+		validators []*types.Validator
+	}
+	tests := []struct {
+		name       string
+		sets       []set
+		want       []validatorSetChange
+		wantHeight int64
+	}{
+		{
+			name:       "first set is not diffed",
+			sets:       []set{{1, []*types.Validator{validator(1, 10)}}},
+			want:       []validatorSetChange{},
+			wantHeight: 1,
+		},
+		{
+			name: "added, removed and power changes",
+			sets: []set{
+				{1, []*types.Validator{validator(1, 10), validator(2, 20), validator(3, 30)}},
+				{2, []*types.Validator{validator(1, 10), validator(2, 25), validator(4, 40)}},
+			},
+			want: []validatorSetChange{
+				{2, "02", 20, 25},
+				{2, "03", 30, 0},
+				{2, "04", 0, 40},
+			},
+			wantHeight: 2,
+		},
+		{
+			name: "unchanged set",
+			sets: []set{
+				{1, []*types.Validator{validator(1, 10)}},
+				{2, []*types.Validator{validator(1, 10)}},
+			},
+			want:       []validatorSetChange{},
+			wantHeight: 2,
+		},
+		{
+			name: "older set is ignored",
+			sets: []set{
+				{2, []*types.Validator{validator(1, 10)}},
+				{1, []*types.Validator{validator(1, 20)}},
+				{3, []*types.Validator{validator(1, 10)}},
+			},
+			want:       []validatorSetChange{},
+			wantHeight: 3,
+		},
+		{
+			name: "non consecutive set is the new baseline",
+			sets: []set{
+				{1, []*types.Validator{validator(1, 10)}},
+				{5, []*types.Validator{validator(1, 20)}},
+				{6, []*types.Validator{validator(1, 30)}},
+			},
+			want:       []validatorSetChange{{6, "01", 20, 30}},
+			wantHeight: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newValidatorSet(10, newMonikers())
+			for _, set := range tt.sets {
+				s.set(set.height, set.validators)
+			}
+			require.Equal(t, tt.want, s.changes.Values())
+			require.Equal(t, tt.wantHeight, s.height)
+		})
+	}
+}
+
+func TestValidatorSetHeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int64
+		height  int64
+		want    []int64
+	}{
+		{"no set yet", 0, 10, []int64{10}},
+		{"next height", 10, 11, []int64{11}},
+		{"skipped heights", 10, 13, []int64{11, 12, 13}},
+		{"already set", 10, 10, []int64{}},
+		{"older height", 10, 8, []int64{}},
+		{"gap too large", 10, 11 + validatorSetMaxGap, []int64{11 + validatorSetMaxGap}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newValidatorSet(10, newMonikers())
+			s.height = tt.current
+			require.Equal(t, tt.want, s.heights(tt.height))
+		})
+	}
+}
+
+func TestWriteValidatorSetChanges(t *testing.T) {
+	var b bytes.Buffer
+	err := writeValidatorSetChanges(&b, []validatorSetChange{
+		{2, "01", 0, 10},
+		{3, "02", 20, 0},
+	}, newMonikers())
+	require.NoError(t, err)
+	require.Equal(t, `height,change,address,moniker,old_power,new_power
+2,added,01,01,0,10
+3,removed,02,02,20,0
+`, b.String())
+}
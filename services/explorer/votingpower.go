@@ -0,0 +1,80 @@
+package explorer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/gex/pkg/number"
+)
+
+const (
+	// votingPowerTop is the number of validators listed in the voting power distribution.
+	votingPowerTop = 20
+
+	// votingPowerShareTop is the number of top validators used to compute the cumulative share.
+	votingPowerShareTop = 10
+)
+
+// nakamotoCoefficient returns the minimum number of validators, sorted by descending
+// power, whose cumulative power exceeds the num/den fraction of the total power, or
+// reaches it if inclusive.
+func nakamotoCoefficient(powers []int64, total, num, den int64, inclusive bool) int {
+	var cumulative int64
+	for i, power := range powers {
+		cumulative += power
+		if cumulative*den > total*num || (inclusive && cumulative*den == total*num) {
+			return i + 1
+		}
+	}
+	return len(powers)
+}
+
+// formatVotingPower formats the voting power distribution and decentralization metrics.
+func formatVotingPower(validators []*types.Validator, monikers *monikers) string {
+	sorted := append([]*types.Validator(nil), validators...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].VotingPower > sorted[j].VotingPower
+	})
+
+	var (
+		total    int64
+		topShare int64
+		powers   = make([]int64, len(sorted))
+	)
+	for i, validator := range sorted {
+		total += validator.VotingPower
+		powers[i] = validator.VotingPower
+		if i < votingPowerShareTop {
+			topShare += validator.VotingPower
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "validators: %d, total power: %s\n", len(sorted), number.WithComma(total))
+	// A third of the power is enough to halt the chain since a block needs more than two thirds.
+	fmt.Fprintf(&b, "halt (≥1/3): %d validators\n", nakamotoCoefficient(powers, total, 1, 3, true))
+	fmt.Fprintf(&b, "control (>2/3): %d validators\n", nakamotoCoefficient(powers, total, 2, 3, false))
+	fmt.Fprintf(&b, "top %d share: %s\n\n", votingPowerShareTop, number.Percentage(topShare, total))
+
+	fmt.Fprintf(&b, "%3s %-40s %15s %8s %8s\n", "#", "VALIDATOR", "POWER", "SHARE", "CUM")
+	var cumulative int64
+	for i, validator := range sorted {
+		if i >= votingPowerTop {
+			break
+		}
+		cumulative += validator.VotingPower
+		fmt.Fprintf(
+			&b,
+			"%3d %-40.40s %15s %8s %8s\n",
+			i+1,
+			monikers.name(validator.Address.String()),
+			number.WithComma(validator.VotingPower),
+			number.Percentage(validator.VotingPower, total),
+			number.Percentage(cumulative, total),
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,37 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNakamotoCoefficient(t *testing.T) {
+	tests := []struct {
+		name      string
+		powers    []int64
+		num       int64
+		den       int64
+		inclusive bool
+		want      int
+	}{
+		{"no validators", nil, 1, 3, true, 0},
+		{"single validator", []int64{10}, 1, 3, true, 1},
+		{"equal powers halt", []int64{10, 10, 10, 10, 10, 10}, 1, 3, true, 2},
+		{"equal powers control", []int64{10, 10, 10, 10, 10, 10}, 2, 3, false, 5},
+		{"exactly one third halts", []int64{1, 1, 1}, 1, 3, true, 1},
+		{"exactly two thirds is not control", []int64{1, 1, 1}, 2, 3, false, 3},
+		{"dominant validator", []int64{70, 10, 10, 10}, 2, 3, false, 1},
+		{"long tail", []int64{30, 20, 10, 10, 10, 10, 10}, 1, 3, true, 2},
+		{"large powers", []int64{4e17, 3e17, 3e17}, 2, 3, false, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var total int64
+			for _, power := range tt.powers {
+				total += power
+			}
+			require.Equal(t, tt.want, nakamotoCoefficient(tt.powers, total, tt.num, tt.den, tt.inclusive))
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package explorer
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/gex/pkg/window"
+)
+
+const (
+	// watchHistorySize is the number of balance changes kept in the history.
+	watchHistorySize = 20
+
+	// watchQueueSize is the number of pending blocks kept while their touched balances are fetched.
+	watchQueueSize = 100
+)
+
+// blockEventsSource is the change source of the balances touched by the block events.
+const blockEventsSource = "block events"
+
+// watchQuery holds the watched addresses touched by a block with their change sources.
+type watchQuery struct {
+	height  int64
+	touched map[string][]string
+}
+
+// balanceChange holds a watched address balance change.
+type balanceChange struct {
+	at      time.Time
+	height  int64
+	address string
+	diff    string
+	sources []string
+}
+
+// watchList tracks the balances of the watched addresses.
+type watchList struct {
+	sync.RWMutex
+	addresses []string
+	balances  map[string]sdk.Coins
+	heights   map[string]int64
+	errs      map[string]error
+	history   *window.Window[balanceChange]
+}
+
+// newWatchList creates a new watch list of addresses.
+func newWatchList(addresses []string, size int) *watchList {
+	return &watchList{
+		addresses: addresses,
+		balances:  make(map[string]sdk.Coins),
+		heights:   make(map[string]int64),
+		errs:      make(map[string]error),
+		history:   window.New[balanceChange](size),
+	}
+}
+
+// touchEvents adds the source to the watched addresses found in the events attributes.
+func (l *watchList) touchEvents(touched map[string][]string, events []abci.Event, source string) {
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			for _, address := range l.addresses {
+				if attr.Value != address || slices.Contains(touched[address], source) {
+					continue
+				}
+				touched[address] = append(touched[address], source)
+			}
+		}
+	}
+}
+
+// touched returns the watched addresses found in the block events with the hashes of
+// the transactions touching them.
+func (l *watchList) touched(block types.EventDataNewBlock) map[string][]string {
+	touched := make(map[string][]string)
+	if len(l.addresses) == 0 {
+		return touched
+	}
+	for i, result := range block.ResultFinalizeBlock.TxResults {
+		if i >= len(block.Block.Txs) {
+			break
+		}
+		l.touchEvents(touched, result.Events, fmt.Sprintf("%X", block.Block.Txs[i].Hash()))
+	}
+	l.touchEvents(touched, block.ResultFinalizeBlock.Events, blockEventsSource)
+	return touched
+}
+
+// balanceDiff formats the balance difference per denom with its sign.
+func balanceDiff(before, after sdk.Coins) string {
+	denoms := make(map[string]bool)
+	for _, coin := range before.Add(after...) {
+		denoms[coin.Denom] = true
+	}
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	diffs := make([]string, 0, len(sorted))
+	for _, denom := range sorted {
+		diff := after.AmountOf(denom).Sub(before.AmountOf(denom))
+		switch {
+		case diff.IsZero():
+			continue
+		case diff.IsPositive():
+			diffs = append(diffs, fmt.Sprintf("+%s%s", diff, denom))
+		default:
+			diffs = append(diffs, fmt.Sprintf("%s%s", diff, denom))
+		}
+	}
+	return strings.Join(diffs, " ")
+}
+
+// apply applies the balance of an address at the height, returning the previous
+// balance if known, and false if the address was already updated at the height
+// or after. Caller must hold the lock.
+func (l *watchList) apply(address string, height int64, balances sdk.Coins, err error) (before sdk.Coins, known, ok bool) {
+	if last := l.heights[address]; last > 0 && height <= last {
+		return nil, false, false
+	}
+	l.errs[address] = err
+	if err != nil {
+		return nil, false, false
+	}
+	before, known = l.balances[address]
+	l.balances[address] = balances
+	l.heights[address] = height
+	return before, known, true
+}
+
+// set sets the initial balance of an address at the height without recording a change.
+func (l *watchList) set(address string, height int64, balances sdk.Coins, err error) {
+	l.Lock()
+	defer l.Unlock()
+	l.apply(address, height, balances, err)
+}
+
+// update updates the balance of an address at the height and records the change with its sources.
+func (l *watchList) update(address string, height int64, sources []string, balances sdk.Coins, err error, now time.Time) {
+	l.Lock()
+	defer l.Unlock()
+
+	before, known, ok := l.apply(address, height, balances, err)
+	if !ok || !known {
+		return
+	}
+	if diff := balanceDiff(before, balances); diff != "" {
+		l.history.Push(balanceChange{
+			at:      now,
+			height:  height,
+			address: address,
+			diff:    diff,
+			sources: sources,
+		})
+	}
+}
+
+// String returns the watched addresses balances.
+func (l *watchList) String() string {
+	l.RLock()
+	defer l.RUnlock()
+
+	if len(l.addresses) == 0 {
+		return "no address watched, use the --watch flag to add addresses"
+	}
+
+	var b strings.Builder
+	for _, address := range l.addresses {
+		fmt.Fprintf(&b, "%s\n", address)
+		switch balances, ok := l.balances[address]; {
+		case l.errs[address] != nil:
+			fmt.Fprintf(&b, "  balances not reachable: %s\n", l.errs[address])
+		case !ok:
+			fmt.Fprint(&b, "  loading...\n")
+		case balances.IsZero():
+			fmt.Fprint(&b, "  no balance\n")
+		default:
+			for _, coin := range balances {
+				fmt.Fprintf(&b, "  %s %s\n", formatAmount(coin.Amount), coin.Denom)
+			}
+		}
+	}
+	return b.String()
+}
+
+// changes returns the latest balance changes from the newest.
+func (l *watchList) changes() string {
+	l.RLock()
+	defer l.RUnlock()
+
+	var (
+		changes = l.history.Values()
+		b       strings.Builder
+	)
+	fmt.Fprintf(&b, "latest %d balance changes\n", len(changes))
+	for i := len(changes) - 1; i >= 0; i-- {
+		change := changes[i]
+		fmt.Fprintf(
+			&b,
+			"\n%s height %d %s\n  %s\n  by %s\n",
+			change.at.Format(time.DateTime),
+			change.height,
+			change.address,
+			change.diff,
+			strings.Join(change.sources, ", "),
+		)
+	}
+	return b.String()
+}
@@ -0,0 +1,172 @@
+package explorer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ignite/cli/v28/ignite/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before sdk.Coins
+		after  sdk.Coins
+		want   string
+	}{
+		{
+			name: "no balances",
+		},
+		{
+			name:   "unchanged",
+			before: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			after:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		{
+			name:   "changed",
+			before: sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)),
+			after:  sdk.NewCoins(sdk.NewInt64Coin("atom", 8), sdk.NewInt64Coin("stake", 3)),
+			want:   "+3atom -7stake",
+		},
+		{
+			name:   "denom appearing",
+			before: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			after:  sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)),
+			want:   "+5atom",
+		},
+		{
+			name:   "denom disappearing",
+			before: sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)),
+			after:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			want:   "-5atom",
+		},
+		{
+			name:   "all denoms replaced",
+			before: sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			after:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			want:   "-5atom +10stake",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, balanceDiff(tt.before, tt.after))
+		})
+	}
+}
+
+func TestWatchListTouched(t *testing.T) {
+	const (
+		alice = "cosmos1alice"
+		bob   = "cosmos1bob"
+		carol = "cosmos1carol"
+	)
+	var (
+		txs     = types.Txs{types.Tx("send"), types.Tx("delegate")}
+		sendTx  = fmt.Sprintf("%X", txs[0].Hash())
+		stakeTx = fmt.Sprintf("%X", txs[1].Hash())
+	)
+	tests := []struct {
+		name        string
+		addresses   []string
+		txEvents    [][]abci.Event
+		blockEvents []abci.Event
+		want        map[string][]string
+	}{
+		{
+			name:     "no watched address",
+			txEvents: [][]abci.Event{{testEvent("transfer", "recipient", alice, "sender", bob)}},
+			want:     map[string][]string{},
+		},
+		{
+			name:      "tx events",
+			addresses: []string{alice, bob},
+			txEvents: [][]abci.Event{
+				{
+					testEvent("coin_spent", "spender", alice, "amount", "10stake"),
+					testEvent("coin_received", "receiver", carol, "amount", "10stake"),
+					testEvent("transfer", "recipient", carol, "sender", alice, "amount", "10stake"),
+				},
+				{
+					testEvent("delegate", "validator", "cosmosvaloper1", "delegator", bob),
+					testEvent("coin_spent", "spender", bob, "amount", "5stake"),
+				},
+			},
+			want: map[string][]string{alice: {sendTx}, bob: {stakeTx}},
+		},
+		{
+			name:      "address touched by several txs and the block events",
+			addresses: []string{alice},
+			txEvents: [][]abci.Event{
+				{testEvent("transfer", "recipient", alice, "sender", bob)},
+				{testEvent("transfer", "recipient", carol, "sender", alice)},
+			},
+			blockEvents: []abci.Event{testEvent("coin_received", "receiver", alice, "amount", "1stake")},
+			want:        map[string][]string{alice: {sendTx, stakeTx, blockEventsSource}},
+		},
+		{
+			name:      "address not touched",
+			addresses: []string{carol},
+			txEvents:  [][]abci.Event{{testEvent("transfer", "recipient", alice, "sender", bob)}},
+			want:      map[string][]string{},
+		},
+		{
+			name:      "tx results beyond the block txs are ignored",
+			addresses: []string{alice},
+			txEvents: [][]abci.Event{
+				nil,
+				nil,
+				{testEvent("transfer", "recipient", alice, "sender", bob)},
+			},
+			want: map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := types.EventDataNewBlock{Block: &types.Block{Data: types.Data{Txs: txs}}}
+			for _, events := range tt.txEvents {
+				block.ResultFinalizeBlock.TxResults = append(
+					block.ResultFinalizeBlock.TxResults,
+					&abci.ExecTxResult{Events: events},
+				)
+			}
+			block.ResultFinalizeBlock.Events = tt.blockEvents
+
+			l := newWatchList(tt.addresses, watchHistorySize)
+			require.Equal(t, tt.want, l.touched(block))
+		})
+	}
+}
+
+func TestWatchListUpdate(t *testing.T) {
+	const address = "cosmos1alice"
+	var (
+		now   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		coins = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+		l     = newWatchList([]string{address}, watchHistorySize)
+	)
+
+	// The first update only sets the balance, and the initial balance
+	// fetched at an older height is dropped.
+	l.update(address, 11, []string{"tx"}, coins(20), nil, now)
+	l.set(address, 10, coins(10), nil)
+	require.Equal(t, coins(20), l.balances[address])
+	require.Empty(t, l.history.Values())
+
+	l.update(address, 12, []string{"tx"}, coins(15), nil, now)
+	l.update(address, 12, []string{"tx"}, coins(50), nil, now)
+	require.Equal(t, coins(15), l.balances[address])
+	require.Equal(t, []balanceChange{{now, 12, address, "-5stake", []string{"tx"}}}, l.history.Values())
+
+	// The error is shown until the next balance, which is diffed from the last known one.
+	l.update(address, 13, []string{"tx"}, nil, errors.New("unreachable"), now)
+	require.Error(t, l.errs[address])
+	l.update(address, 14, []string{"tx"}, coins(25), nil, now)
+	require.NoError(t, l.errs[address])
+	require.Len(t, l.history.Values(), 2)
+	require.Equal(t, "+10stake", l.history.Values()[1].diff)
+}
@@ -0,0 +1,43 @@
+package explorer
+
+import "context"
+
+// worker runs the slow queries triggered by the websocket events on its own goroutine,
+// so the event handlers never block the subscription.
+type worker[T any] struct {
+	jobs chan T
+}
+
+// newWorker starts a worker running fn for each job in order, keeping up to size
+// pending jobs. Like client.Callback, the worker stops if fn returns an error.
+func newWorker[T any](ctx context.Context, size int, fn func(T) error) *worker[T] {
+	w := &worker[T]{jobs: make(chan T, size)}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case job := <-w.jobs:
+				if err := fn(job); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return w
+}
+
+// send queues the job without blocking, dropping the oldest pending job if the queue is full.
+func (w *worker[T]) send(job T) {
+	for {
+		select {
+		case w.jobs <- job:
+			return
+		default:
+		}
+		select {
+		case <-w.jobs:
+		default:
+		}
+	}
+}
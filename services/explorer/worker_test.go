@@ -0,0 +1,39 @@
+package explorer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		done    = make(chan int)
+	)
+	w := newWorker(ctx, 2, func(job int) error {
+		if job == 1 {
+			close(started)
+			<-release
+		}
+		done <- job
+		return nil
+	})
+
+	// The first job keeps the worker busy while the next ones are queued,
+	// the oldest pending jobs being dropped once the queue is full.
+	w.send(1)
+	<-started
+	for job := 2; job <= 5; job++ {
+		w.send(job)
+	}
+	close(release)
+
+	got := []int{<-done, <-done, <-done}
+	require.Equal(t, []int{1, 4, 5}, got)
+}